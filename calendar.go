@@ -0,0 +1,180 @@
+package lib
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// BusinessCalendar 工作日历；周末和登记的节假日不是工作日，登记的调休日即使是周末也要上班
+type BusinessCalendar struct {
+	sync.RWMutex
+	holidays  map[string]bool // 日期格式 2006-01-02
+	workdays  map[string]bool // 调休上班的日期
+	workStart time.Duration   // 上班时间，相对0点
+	workEnd   time.Duration   // 下班时间，相对0点
+}
+
+// HolidayCalendar 旧名字，保留兼容
+type HolidayCalendar = BusinessCalendar
+
+func NewBusinessCalendar() *BusinessCalendar {
+	return &BusinessCalendar{
+		holidays:  make(map[string]bool),
+		workdays:  make(map[string]bool),
+		workStart: 9 * time.Hour,
+		workEnd:   20 * time.Hour,
+	}
+}
+
+func NewHolidayCalendar(days ...string) *HolidayCalendar {
+	c := NewBusinessCalendar()
+	c.Add(days...)
+	return c
+}
+
+// calendarFile 日历文件格式，日期格式 2006-01-02，工作时间格式 15:04
+//
+//	{"holidays": ["2024-10-01"], "workdays": ["2024-09-29"], "work_hours": ["09:00", "20:00"]}
+type calendarFile struct {
+	Holidays  []string `json:"holidays"`
+	Workdays  []string `json:"workdays"`
+	WorkHours []string `json:"work_hours"`
+}
+
+// LoadCalendar 从json文件加载日历
+func LoadCalendar(path string) (*BusinessCalendar, error) {
+	c := NewBusinessCalendar()
+	if err := c.LoadFile(path); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadFile 合并json文件中的日期，设置了工作时间的会覆盖
+func (c *BusinessCalendar) LoadFile(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var f calendarFile
+	if err = json.Unmarshal(b, &f); err != nil {
+		return fmt.Errorf("calendar %v: %w", path, err)
+	}
+	for _, day := range append(append([]string(nil), f.Holidays...), f.Workdays...) {
+		if _, err = time.Parse(FormatDay, day); err != nil {
+			return fmt.Errorf("calendar %v: %w", path, err)
+		}
+	}
+	if len(f.WorkHours) > 0 {
+		if len(f.WorkHours) != 2 {
+			return fmt.Errorf("calendar %v: work_hours need start and end", path)
+		}
+		var hours [2]time.Duration
+		for i, v := range f.WorkHours {
+			t, err := time.Parse("15:04", v)
+			if err != nil {
+				return fmt.Errorf("calendar %v: %w", path, err)
+			}
+			hours[i] = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+		}
+		c.SetWorkHours(hours[0], hours[1])
+	}
+	c.Add(f.Holidays...)
+	c.AddWorkday(f.Workdays...)
+	return nil
+}
+
+// Add 登记节假日，格式 2006-01-02
+func (c *BusinessCalendar) Add(days ...string) {
+	c.Lock()
+	for _, day := range days {
+		c.holidays[day] = true
+	}
+	c.Unlock()
+}
+
+// AddWorkday 登记调休上班的日期，格式 2006-01-02
+func (c *BusinessCalendar) AddWorkday(days ...string) {
+	c.Lock()
+	for _, day := range days {
+		c.workdays[day] = true
+	}
+	c.Unlock()
+}
+
+// SetWorkHours 设置工作时间，例如 9*time.Hour, 20*time.Hour
+func (c *BusinessCalendar) SetWorkHours(start, end time.Duration) {
+	c.Lock()
+	c.workStart, c.workEnd = start, end
+	c.Unlock()
+}
+
+func (c *BusinessCalendar) IsHoliday(t time.Time) bool {
+	c.RLock()
+	defer c.RUnlock()
+	return c.holidays[t.Format(FormatDay)]
+}
+
+func (c *BusinessCalendar) IsWorkday(t time.Time) bool {
+	c.RLock()
+	defer c.RUnlock()
+	day := t.Format(FormatDay)
+	if c.workdays[day] {
+		return true
+	}
+	return isWeekday(t) && !c.holidays[day]
+}
+
+// InWorkHour 工作日的工作时间内
+func (c *BusinessCalendar) InWorkHour(t time.Time) bool {
+	if !c.IsWorkday(t) {
+		return false
+	}
+	c.RLock()
+	defer c.RUnlock()
+	h, mi, sec := t.Clock()
+	offset := time.Duration(h)*time.Hour + time.Duration(mi)*time.Minute + time.Duration(sec)*time.Second
+	return offset >= c.workStart && offset < c.workEnd
+}
+
+// NextWorkday t之后的下一个工作日0点，使用t的时区；一年内都没有工作日时返回零值
+func (c *BusinessCalendar) NextWorkday(t time.Time) time.Time {
+	y, m, d := t.Date()
+	for i := 1; i <= 366; i++ {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, t.Location())
+		if c.IsWorkday(day) {
+			return day
+		}
+	}
+	return time.Time{}
+}
+
+// AddWorkdays n个工作日之后的同一时间，n为负数时往前数；t不是工作日时从t开始数；一年内都没有工作日时返回零值
+func (c *BusinessCalendar) AddWorkdays(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	y, m, d := t.Date()
+	h, mi, s := t.Clock()
+	for i, miss := 0, 0; n > 0; {
+		i += step
+		day := time.Date(y, m, d+i, h, mi, s, t.Nanosecond(), t.Location())
+		if c.IsWorkday(day) {
+			n, miss = n-1, 0
+			t = day
+		} else if miss++; miss > 366 {
+			return time.Time{}
+		}
+	}
+	return t
+}
+
+func isWeekday(t time.Time) bool {
+	return !(t.Weekday() == time.Saturday || t.Weekday() == time.Sunday)
+}
+
+// Holidays 默认的工作日历，启动时自行登记或者通过LoadFile加载；InWorkWeek和InWorkHour使用它
+var Holidays = NewBusinessCalendar()
@@ -0,0 +1,45 @@
+package lib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBusinessCalendar(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "calendar.json")
+	data := `{"holidays": ["2024-10-01", "2024-10-02", "2024-10-03", "2024-10-04", "2024-10-07"], "workdays": ["2024-09-29", "2024-10-12"], "work_hours": ["10:00", "18:30"]}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := LoadCalendar(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loc := time.FixedZone("CST", 8*3600)
+	day := func(d int) time.Time { return time.Date(2024, 9, d, 12, 0, 0, 0, loc) }
+
+	if !c.IsWorkday(day(29)) { // 周日调休上班
+		t.Error("2024-09-29 should be workday")
+	}
+	if c.IsWorkday(time.Date(2024, 10, 1, 12, 0, 0, 0, loc)) {
+		t.Error("2024-10-01 should be holiday")
+	}
+	if got := c.NextWorkday(day(30)); !got.Equal(time.Date(2024, 10, 8, 0, 0, 0, 0, loc)) {
+		t.Errorf("NextWorkday = %v", got)
+	}
+	if got := c.AddWorkdays(day(27), 2); !got.Equal(day(30)) {
+		t.Errorf("AddWorkdays = %v", got)
+	}
+	if got := c.AddWorkdays(time.Date(2024, 10, 8, 12, 0, 0, 0, loc), -1); !got.Equal(day(30)) {
+		t.Errorf("AddWorkdays = %v", got)
+	}
+	if c.InWorkHour(time.Date(2024, 9, 30, 9, 30, 0, 0, loc)) || !c.InWorkHour(time.Date(2024, 9, 30, 18, 0, 0, 0, loc)) {
+		t.Error("work hours not applied")
+	}
+
+	if _, err = LoadCalendar(filepath.Join(t.TempDir(), "none.json")); err == nil {
+		t.Error("missing file should fail")
+	}
+}
@@ -35,29 +35,45 @@ func PKCS7UnPadding(plaintext []byte, blockSize int) ([]byte, error) {
 		return nil, errors.New("pKCS7Unpadding text not a multiple of the block size")
 	}
 	paddingLength := int(plaintext[plaintextLength-1])
+	if paddingLength == 0 || paddingLength > blockSize || paddingLength > plaintextLength {
+		return nil, errors.New("pKCS7Unpadding invalid padding")
+	}
+	for _, b := range plaintext[plaintextLength-paddingLength:] {
+		if int(b) != paddingLength {
+			return nil, errors.New("pKCS7Unpadding invalid padding")
+		}
+	}
 	return plaintext[:plaintextLength-paddingLength], nil
 }
 
-func AesCbcEncrypt(src, key []byte) []byte {
-	block, _ := aes.NewCipher(key)
+func AesCbcEncrypt(src, key []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
 	src = PKCS7Padding(string(src), block.BlockSize())
 
 	cipherText := make([]byte, len(src))
 	mode := cipher.NewCBCEncrypter(block, key)
 	mode.CryptBlocks(cipherText, src)
 
-	return cipherText
+	return cipherText, nil
 }
 
-func AesCbcDecrypt(src, key []byte) []byte {
-	block, _ := aes.NewCipher(key)
+func AesCbcDecrypt(src, key []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(src)%block.BlockSize() != 0 {
+		return nil, errors.New("aesCbcDecrypt text not a multiple of the block size")
+	}
 
 	dst := make([]byte, len(src))
 	mode := cipher.NewCBCDecrypter(block, key)
 	mode.CryptBlocks(dst, src)
 
-	b, _ := PKCS7UnPadding(dst, block.BlockSize())
-	return b
+	return PKCS7UnPadding(dst, block.BlockSize())
 }
 
 //----------------------------------------------------------------------------------------------------------
@@ -10,5 +10,13 @@ func TestAesCbcEncrypt(t *testing.T) {
 	var s = `HDnKoVMzHmEI3AYbar0csM254Cghebk5xef2B9ms9OQzLSNUcu8hsDOm75QhLDmmxQmmEUfSlucPWDoBV2vALnV3zducwAhbd4fwbiCQwfhTbzbxrKi73T1l7u//LpGHRC5WIQkDybX48ctCTBzOCGX2HlQatiTXLOMRxKM43ytzWSOiTlzIaXMJb6tjEawmAbizdKQgcDyQUoUme/fF8UCo8yPaHK1uI0n5TPXSTb4zVsBYB0LizqCtj7TrZRCaHxtRGfKMeUE/lGVDJjaW6WFUsTdBpP3Ox3T8qYEqQcPKisz8u7WK2SsGSLXA6kQBEt1yvkvQcVy/tbHQWXBuf8EnX2JFooaOal/Jwpg+g+lNJ+864xDYX1sMiSxFUvcMGI4ru+InAeI3oPajTXbb0Fe1c7WmBTMTE1ZA/UoGwq4o6I8jsnXnbNdOyD7JODz6Hm41d7GwWr1w+xh5kJUnaJJtNDoUXkeqew0SW9+SK4xN4iIPt5Pt8zU4fyq87sW+g0rH2TE5AkqrSq1rdIvVNAn34ckICeLjzpn9RRDt9bjC3CNla/74WTf28ZKXOpV3VD61D3lAazkJETSzrJrvZ9ZqCR2AEsgyXJZSR8S8DlAUvUdkrsw32AdbsdPu0E61WXItFOVDba13ZjjX132E/7v4PaxqnXKG1QDfqGJLaLxPnGqkQLgkSX10ejffBHe/DGMWvAe5tFl66rGus55utr0Pil9SttNoGmNdwGsDAFFENhYKzQOwmpU7TerprYZP9r1mAJ3z8uW4CspszOp8wCoBe4T7rA2gKb2dQNiFKNfEGnXkx6bZmY+SvXRR6cpEX7ZgQ2gUuE4bydKqK/AL7FzlFsukjpjRd0kDQgBFGqq/Z7G0U6mWRz3+Ad9Jc4nwiUS/NmomCqugADFJh1g++Wo0n3VqzR1JReH1SCMDeH7llf7aGlp488+No8xqK70yhZX12XLWxWKxx8ImigQLbIXxO09rRIvZ8ZFjMmeFuAZXmfelwHKUiM7Vryght/oGz9DNQTNAJw6dop/oIu3Lini63OSmuNZYS0co6r3WUBdxLbYFZyixnRyVF5sGWTDogEg7rL7Vb3CYQZ/XPEB2chq/obPDXgHtZy6d5+oU+txlnSCT0gpXwCfleaUvAP6mCOSjy3nIfgVa+6LBRbqX4xd0qNEITeXLyUzTLYwrLcTkD4UtBbCJzP9gO07YXWIXBU00tGEOLI9+kkfnV1P9+iSWvlD6QTyeoWeckpH0A4G9xotmcBwyeL++5seYxwLEmIpisrGYHCKiFkzV+ytfYZ9aF/i+edalfOEEXY4Bm8iwWi5WnMFzOGzgor9TVWKhkdlFAMTItqfbUDMQrkay2nZCQkXlcbvLNmn4M89nWpWMvyfaO7XhrcVWwNuoFJpP0s/5p2bXncHULjGN/NTgPHACcQKbOtcv6S49+MuXjCz+zm5DUd/qC0YB60QZFM3l2zyPokR+HtziVdLeOwio0E0cT0ljDxr0vvwd1QS0abvhdnJGKEFlZvUVncKW6iKDyHklPJ+NMJzG2ZQONndstE0YQnT4I7/4LoYQBljz2XcSBBfviDgRQKzM1oDKnL0BQVFNAoWDaUczVtsTr73OunHCgZ4dLA5uYotsM8Id1WVcYvfRdLeI1O2W/FzJC2pleO86dHUWZ3C8d/ogGTqd+txPCDQ2873pl/t+Fl9mMP1jqTZuexH5yaZvbvuL5LfJtm3uLIiC30yKkSUjVkmFVhsSbE0cG6mOKM9dh85DKeQ2kfM2AusbQ6snmb8b1uvTzL0iUe4V7fqa1GntEgeWQnDX9ge9f3eRaNJOZ80vI3On+yo59BmLctymO9DpxOa+r3T8LpF/70UnwGGtu3NSqoy0nNj/MIBmLkLqMTAvap9f5Y0huhl9Ft7fow+Pt6W7r11KSpqPtwUGL6PA+f4uL8gjL8bgzKfigfEusUv6G2zM+3ssIrDa0i+A4X3WtDCXWRr/q3kMGy8BgkMIPW/AK33CHVKlLg5xsnSR1uJ1tO+DwDacSPq4S4njIxmO3qCxBPSKACcvyAWcT7k6fDrTF+k2khKBAQ4/fEjA/Jz8Y9Hxp+0OZw5xC0mx5y004wsD0pmIEovZVyCsLA/CguLF3M4ux1sNLZzNWxvDyglbpNrkOrI4wUF3mbVhPx/ol1BFwqNnwtXnxOSb5b1BgmDTCgCw4NuINPU8jKZTHn6xKvBHROZdxS22WI0m34inyzik5HXl+DrJxtTfl4T3Z6O4rMBdZokZeIuKhG8qBchIDDyC32PXj2DVbn377Xmr4pnns8eJUCngo1vGzVS+mvFyuenwPuXe4x11VOe1yfozXM59Asi9TUG6mrd+QSc8gbPtSAXl1q/Slwr7/9dTBlGQkVQ8WXH+mEZJOriCzmiMaLYjAWnxIfNuj1q9Wze9DYvkU/kgzpfwZ3XuVBcDv7cRRzNa4qrCxDK7adVr+DuNz6TGLnrrd1U5jPzXY6kXHUEFt/dnRrqybuefNd5P4IeiYRWQK7zJwAjwcK+OOMiZNL9peOpaj1nSYXXeGz9J7CwknmwoIOgnRtcYBgJOybG+it1ajAy83sSlKGkyVtysP0OKxC6nb+y4JxS9qr34BGN7wR+9Lo84fuG9oq4F5Vw2PwqleHpO+ODnzZsoPuhNxWJ6DFikfKTTXBHq7E8LQIu5Wxwdsvhzx39xe1pzcFqHpGOiS88hK6Oerimse/TiG4/uOZmso90ryj41w8ULxiPpCaaY1aw+BVEggm2/30v9ZeNHWkDqCXNtmaqRnz5ILPiBnMnRohDtWPuerTe+q7xsIWtUCCgPe+6hCW/dh/iLD47tvDHVlKqPLiWlG5j12108VfsGTfLQjP1jHRxIYfjbcxG4frZYwJFZsRZlsLsNR2Bf2PZP/bw7PRej4lCYQ6aGJea+88+mUvyMd593n9+c2ZtPh1ScmHECIkMvFKv9zerNV8KN83VBuSEOGfA3el6rfwZIlNFnPQf0gHDrXFWPsTebLkKyvp1r5b8Zj9KlEAHv2Xkoq2veq2jzWZjRuH/4tMS5t/PJb/x8cGyH0kpeDaaExcPETgKESwCF8XFrGKEOmGCReSr2Q3LWRnOIMsTqQuZMOfltO45OBLBeIOzFzEsNyIBVmdB3qU69OQJ+E1z9MlD3W1N+/j4O2gRpfCzeTaWxLALIKHNgFJyr9Z7a/8+8oZt5Es2u/wKCkRISAFMezVjxcIZ4HNwGTgA/yqKvXx0G+vf44XLoQIalLiABewOqiRUsymlxU/tMb2qlSXbNkisc81qZbST2ea2ADfYoxH0jmlIYV/9K6mV70ca+uhIWpoWbmZhluBpjcWk11oal8jV+ORMlL0tVFIkXT7FIkaiqIf2LaqEFyPI/n9j2+HySIqdnQZQsdRWAfrnZkmsNtNvwFzTE6BLPVIfLn758kmEhAgKBthcGT+VmGS+tqz0euHqNX8ObIOtOuz498LP43nUfy0z2HqEFgRAsP89tLxn0sGlSG/Y8JqCVZQJrc3DIEqcDCCNK1j9st7qs80LEx/U7OGtH688lr54ZIa1G09BltKhT/SEB2uoKk6H00mwIN+7OsvJqSAnNcq0VNY0fExbmHQVmAqJvLcsXWpLXDy8BX2bVJ8sUaG56QlvYlmmUAJJEVc/O0x/LMEKt/8JN8njv0mAl7VcNC/NU8MtRKMBTJhvqFjIJbQ9bvj+0XgJv3zMTeNSQ37usADCKDU+TUyugDBA/d6HGVEx+4POAOVZOzYryXRWbgf1MaKukkTWAbbitGEZf1n+8g33zKLPbRTLgPD7xqiKvoUFFm96RXVi3KeFFXCk8KT5sedEPsHjtSMNctW84K5Q0n7hR2xtoma18qY4+56yGTGb4oPSlXuElMqjf/tfVQOtpUhFSkXgUpra18dtnyRgvuUdedgJxpNyxQlTzc/bna6j1I/5AERzJkeNV0h9RLz39ePUuaKR4tjwnQMgTci0xfaxrdSBvtAgHhHDtL0zYRW4G3DhxXll2ZU4rfWwk7JXCoRNtL/74fK/LQy8xdD+ZkqS42LHnbKBICMhJzS4Ie2wc+xIuVRNgsfX2rf1NhSZNtaYgTecQivXWKexiGHQGuil+MZEnKxcOGtt7dzpUQjaVxiOEYw/+7asF55IthE1serAsZ+Y76wf16GQ0ISL3CK0zXbp3d0YYxk8ArQ6qVDrTEnw5qKiG/B1TJkj2Kyepp3rgVCqDq3uT973oIGicFZV12D8dQcCSUTzM+VX1xNe0D+eCU98tsDNiBc7O9+80R1mYQCxY7qXx/iCLn9FBEFcQt6dFq1LDzGDSpDRQZm82cjTPMQQe7JEnL6B3txMG6/2A7/FA/1ijKTjgGQaK9r1cCsWlyOG9JBXpWVqwLd5igviOxbg83LID+yGD2YzubQOpR4XXn3C5XfBSed5lvmzvwUjcX256NTUdskV352Yp2egxhzzRcqISG+5VNdWEEz4LzFRtNU4RoVgmlactqJVm1MeYYWhAqokUYtPxnTXdYEE9rNmB8lqouLas8LaxOJusOAVmyi5CyQIvHdh5jjfiSKmIJpcfMPEjtSZU6DBhV3TnQDYEYGCGLpb0t0i5z4+sb5+PTyUmzW063clpwaXsdv3nterWxcivZlAf0C730TRCITz85okTL8Wuk1XoTuG5KEzQwjqs+7Fca8ow2IGpWX59uzQHKOCpKUTOytVO1U2vQJ8A76f6MsOSjXfarojfestJ1CTygaQ9M/nch6tlwykN1M/iXzYG7GO0dMhjSszofgjObYT7wwpaxArq5PhfioWCtDrqpOiwXlKasS1eN/eZZHLvDmK7SE8kjWBOeLkDEonVgD0Y0Q1PTepR79TKGTncwKkBXNXGr0/ScNGXaxN+GdXFX2q7OGm/oG2grJFlO/gXWjYv0N62XTHnwDETvXre3iF7ZW0sWZNh9YEV/MEhPJ4ExQbjXE65ivmy6xUHTZhh+oaeyAuaDsaJCujZEB0bmtz5Oi3gd9Jen0hzGqmQqMeZRMlb7XDglFdSvnZoam0yN+opPbFAlhBpEovrdNU2n5klrr8WNXtMZrAWqp+QYrczq9Kpqrffkp+PFMNB+q6NVdIuS+gSmlc7rk66zgzxfiYwvt0VZKTsj24M31EcoMg8PxR26oHadkGuxTvaeGqMp86R/aWs9cyI8qF+aak/Pjg9pWbq8e48zuVOR5Vy/2k7mAoo8G0/E6TedYEpOg0pfINx+mzXOMOWVpOrKlY49VLevH8WjGmAzEK6XfPdxEeK+9VeRNkTrVfeAhixqUrh5XyqSy5mQEb6H080PQcot6i+4xZQlkFxodWPM03/cf7cI0U0lKhA62XiitM44N6ajE1dSt7TUnCoHQteog7PTmGOvnY2qSRcLZNYT1nQFMMKtysdK20n42u6GRH/tz14IK8v1KopA2He3duuM6Wx4B5nkEATKS9tK4eNq98DYA8dVvaHZwX2QnMs89G6ocLRIkQHdpnnfiDVjRNWHS9PkRtYgIYllYVKX3QOPbjxTaLv/tdnjdJBZhCgl3jSjP8ONvTS4CcVdEazvgxh7KimXr8T6x+5hs3mPRm2isoA3+8kdihv+VtzZeY3zOODXnYC5JbBk68KRwZlrjWJZxLc/4jZiDd1vE8hZ0T5GEaHEorIg8GmSLGeNiwFWBTFZM7o/mC8NUJR1VQPhNl+hFXn7wqAf57afZvv3CsvL1BBK+Q9BOCxrnhH4oRKkIDvAqjCmwrmfdXtZG56fQActvtJ33kKdt3E3UJQLnkfExXlW7RB96qxZ2oyfV+YU+S23xPKJyzhZD3LaQ5XohemG3c3Lb6nsXsp+2CdfyCjKCF88bNywICoNO+IytxOnLeTZNerVrezdTCe6mBgmjQNWQbxygthptfs8R03Ack0gcALLkA3gLLHNfr+uaCLNgZ7slU+iVF6By15EZQPenkxrrxnEnpzki2f5R+WPirmYTVFGLXNTDqJn8oMFyQZSpT/k9BkFW8jOdnm8lijzsdBkJL2cVr1fJ7njWqxr9Mq/08nltlMpLo8RCYxCY48u/Nqh7odE8fpqLHCEB6rzRdDSRN0olt7qC31Nmu0JBHB74/ec+SRgGMNi9lx1o9ISHMizNW985y/Qs94D9U0lovr98Kyg4IqDMHvq/qoDnJ/8BjM8V/8EN86eMOiJ3pai9lX+3TphQ930OQP2tCzGjyDnY4xC1LeEb6FzW4BbfdZzIypDrNHwPmVmtj40DMHXVeatA3fP/YQ68iNF0x9iob2EU3LHespXklhvDQpdk1KI6bi5ydidOs4/T+y1D90AB3Vm+QzJ3l+7Q2GqcSSfBBjfssiffvfiZGCEMdvtwwTt3UPmVMT/MQbTW8bcSaYu8x0BoSDm9IrqrvBa6fmh3TUrhRC8raTK7nJcvrQeJYe9OgW9qP4nQyO/WydFbV5JBLL1Uz98T0/ia12rVuPaVs2XdCr9Gvyufpkz+KF6mdk3jwbyBoYagiiLRBqiKk1vdS2vR4CXj4JpkTJ2cTX5aJbl34MJYEWPXkHtOC4FDzQI4snzENkp1d3rjfuyTwS4isQto618BvWDen44H5jSMte2uP7FAtew1IrYxhcw/ni01m5crhEzvkteWB9jb3gXH1V7JzHo+OzzSLmeR4fOhIY0YeKV7SBkEpNKJ22dyJzkVP+HFh/BnjEeU6yLeeEgNem4GuwfYX9GQe+tXiXvOA/AnM998IYGNHV8ST0KbPxWnBfc2XCXY6uf2+y9JHIj/VpS8KsDbcrEfB0WmjzbK7JwaTy4HZ/C016xZsm5pnHwxrncHbjQBF1e4Wy0s6K9kIEx9u075JhJRbvf4hfAEAu1p91QXUTYGl16rbxp+rxuu4dM1f3AI+2eFVe0ZwXOOwUTV5lMCpWsoMLNZ6KwyRDYSwiqKpqQ/1gygUvBWxJ3VXaVM0mEQyMslI+CmubZ5eu1WIpU1I61ifvq5JMUWSpmfEbWsIjtChvqiN7r7qrLQC+h3oL65bRApl8afJtZXytnxystJyu/vyU2Rpdv75+p8xVmXoAhMRSjniFfDCl2KMXBwBsRf3FCBz7G7AmuVRpTvkQdZY7bdhySrSamX3NV2RzTD/vpBVsCj01Fnr6IAUjTy1jtGtKSCwPaO12tMiykFWYWJkl15PmuGkb3K9jXKb4Ecsc8GZppTY6dvEtWqw8IRER7iEVhafyf6gd+fCFZMqCzaF9e4coIp+KrbJChO0nLWNLwJzOAD0kxO42z1iBJLgX0wVgQvY/TpRw03eriTm8DnjHvNW7+Suqm9FXKx/em35hK8SzmgCUOWAcnNdZQHWs8c31dmXHCirirtBxz4fvUWHp3f07+D0C1U1/rGltfbHKoTjUYtYLpYywf0KrjnOvtkaLdolOwERY+rn64gGv8/alVCo+p+aUpJHUr1DoqkM3fxDvD9nxsNZGqMiAmHo6XM8+jWWx82vpkF7vR2v8SbqCijwIPct9IcSOV0fkf/PVRwgV+o/XMHznxz9zUDQbXhgC5SxcO0aN3H9wpT7Z31TNDPBM/DoUdcYi6u2AHHRrkIz2XSHryZnLRPB5CImaw5qOTEmyQe7OwAaa31B+iV5eo3VMd5kpYCZO3uG8QANUjFnVMYzGugNd/uHq5z8sNw0bUI/yPWYB42yK+VnhBYLqAuqP7WZENoUClwovRoxHdishEyOgYlBLcowCWWOXTXVw1wGc2dA9n0EVhJcBfmpApdHPLAf1pRJXJmEnOGQzNSNxhUPD2W2+jPrMcALaB23yQ/9Q6Z8H3ZcxTruMvBCgZz9eB7ySSrHsu/Zjdi9YFoV5zDrl4c3YZcF+geSwX+QzwKuZ/Wu+cEmfq07g6hdkg6rPLHu1LSsMRapvDFfSJRv02TZu8L8CqprExA0nCsRN2puC/0aUu0ANJPjC3/AOvpMB6qCV9bcfC7Y93FJ15HD+neY5GZBrH9acSPs8lu8y+a7f83dG2eFK6bJJesrLC8r8K8hs7IKqTtT8XlArzDrh03I6shlc3EcnnDN33Q7ZHinwmo7e04oC87PTbGXUz308GU3Nn3G2HiD/S0fha5Ug6YR9RLE7HgkI8qwzcgixQ63kMqGDbujswY5aHnSI1F85g57fV1Ci9IZebTU9sE7OYsjDJ/9QcsMH2/e1xbqoNXhQLdd7FyiKcrVnA0158jw/8bZn0STGAoQS1aMrChybAz+n0+7IipC+08bCZ9qxpiBQ2hfqIoucOQIV0sIpHCOdT8Z7qQ6icW4zLKCc7jTgjxbIJ8yURhABUeUAdd0xU9/pOiWk+1EYLbpCiX47l7lMXdJ4EQVrnKGpeFdAHqNnQLDsjcqk83LX0BEgmKaCVw3UPmz9Vk+P6SQamYsY+kkMak9y/EUrnWN/KLb8kP+EOEiJeKMZ7TRCnfLHBZM6RKHGEPpQJEzHcgGato8iM8DBOk7kZJoDnfoQcyzSnL/FJnsWfWY9kHQnsjwVYdQtBXfvhckrCgsZ2zp0VB8LBpNIi5ho6IuAwK+IbIkigoEidn+YhlsNIY5olJ2w9YhoougtFnLolDCfQYrs+dBMOSO0oPJUm4zO5ZuCJbjUWR/oNOSCkg6v+EIFYiHKjUN+EFhrsK0d3B6MqLUvDYNXr8bA8oXMLCL73lCZCQb/Jfo5jyEKIT4j8nja4WOSLp2C/r6a9IlDeSWkXOjPwIiZw6ROttja2cp+BoZKxdOqQuI7/fVpu2RHVX9FnE7ghFAlZEyhPgik8opUjalqbWdQWqLqQrByoLpeGz43ACat6a6ZaAO4cGQxATAoLvNk5zLOz/3QWc7H+OQcdM7Ux5+c5UwS+wqvDB4WA31Zpo0meBCQ55QukbqCvpWsA1AxmS4yWFCMdNcZC5ITalJ4MPFVFHl+vrphgMc5t86OKYLRRGpTtCBgWpsK6VTMvjlm0oDSegNOUYIxS72QQ4D1yCpk3kptifMxD0Zvs1LGo0E6sFCwQlgM5i6wf1pElRTazeNWXBSkpwiQsAWxmpQHjIWKlVJ3q4jJIprObfbTcruPwKHypGty6LQDsPxN8Oeo7V9xpzR2K2wTNHGNQePXU9d9WFabddmzZVpx2+p+KIKawbzYLcOyTRTb1f/pi9IKufhlg6qu1THEUBm28sf9Z1Z1Mps5U/DZXjuLh32/6QV7Xhnmee8R1EOFPxJlVURQahzIHFTs2mrvKmjuYnCblxCYEyLAWqHIenrZSHXsVekfhThEmJ5D0C6rYWItzZt6TZKPDaQDPtLQmiMwdwl/BxLb7+QJkPQUZZwT/9xvK9TNQZhdhwKRreB1Uesi6My9vFKy+pb5O4JcaBuvAGnDSGi3uAK0P+hglIXVbfAMYJRROwTv68PI+2QVL+F2N+/0PWM/F3F9Bo8skRQXjb/ZMMTyzafgZTeWyaJ50k5nByINb32a+pqAl/eC9PvyvrRNYBUkgHSBT08NK64eO8qxXCBRV93NKVEf7OC1gU+L8rocBYbLjzD74GDpJubuxz6vL5Gn/MS4NBnocYp+ecu12A85v8P7JpBlBEai8SW/M72mPjHEH/iUBvst6m5jjN4F2Lsndtb6b1dhKmDTsJQMozzCa79Lf6Ao5CUvZTVS0w/B/FcjEmhyJjEUFUUi1huYsQDCQsqYDrYdOjdCccjQxRN/iA0U4mcA6ufE1ZvA18GtqGw3gdjLUMPsmHYIS5lmupg6mP60/6jZQYdw1/3aPbJQYIcScsqdBbtNtztCcq2MdDdjHcJauGEiYsIco8ATPqv/9ML2jFskO0l22UkqmeGV2v6EHoxStuMfRYQGZb7mzEmU8ugeHjYvqaHuXQY6ccSx+7nyh5ln1KFfNm3aryrQ/LCtu40h1WTsOU3Ngo4SH9ZB5CJK6fuuQ09DkmGxCr3Vva3UR6CXAja6tUg4rCTNbNlUHFTX3K8svsKR2PiEj0WZaSUAnm1Vk6wx6P170SnKA+p6GLuFdvR0ezOxgUy43/2eovbVa0kKbRSUHhbzOpKyQPVxk7mB2bOp3wTf9riD0cf1jDTe/G2UbHUEp8K9zRUp4jrUpeC30IOwy+UCrGnLt9HkYvBhhvqo3aRzUkXb5MxYMLTQ+CIYTn40wg4cS6SeFkQJLAySgHxPcmPsyhBoUF4mdO/gJFK4UFVkCNgPLK11tzDojBrGz/sm+78CkZQKOxYNkRyOkmYK8bwG4NRNLYv+Vbldg2xOElzt+OxgYlHhwVEgoIBMThJNz39zFgVtUYlFN2t9fllQqXx+sxWfrafGc01chF+S6L6m/xffGCTmytvGrv5uNcZy4+KMH66rjqW/Go3o/pdZBpGq+Cr8rn5Nrnw2QkhclriJBQa5CJD5vLvPbb4OieM5AXqCo/s8hF5STgF7AM1zyiNkRh8xgVJzQMyLsrRlGQ5xKd0iFiicx1qWJJ2R3azu8HvYVYYfr7Gm9pTPONnt+yXVLzR1QRtZzCCDFGqY3okZDiT0HPl7+CLi883Dag0wPTPi6yckW11TW00X/upT0puWc2pWW3wPhSoy4RqKz32HAGUh+YIwtYC6ROez2mwyIQ4kKQ/IPzH7cU3olD+ref5YwfLjQ5aBas38MfJU5GffwopMbiwBqDL84igZzs3abnTi7Gj6rDTFHGeHVuwrg575TUY8sZAC3XDLZuwxpxMfQwtSnTf7Ld2T6c74pUcDcWBHl3Y4Eq1o1+JONkcLR8GUUQUFSINXIEnkr3KGKzZ2BjoWxjCD/+kJ29oUcQ6iNqLoO9frjZkHludDfDEvcPcmsKo4m+faZCEwn+EIZ81gWQczosjL9H69eNQxGOPufIYvk5ddSO2niBXhTx200vZDaGvd1nriUO1xEbBB8ad5xYUiA7EafEYP4WpjUW03koFYUIiIV8hQ7Lukp46ir0/ktEj9E5g5E+1QynxPvXkjY9qiUd60J85m+D3x/30Pp8DXQz+dBibU7vI/91BUE8RogwSoyUQJhy69fYMZVqidhkK8tOZyfLqeKwCGjV6xcIHK5v0h4glThnPc1u3V80jmRxMS6WdUY9+zy5nfS4TusjM3NFxDqfLhJCcHD+DScF/9sDCu9433moLmxpRXCz8L+srf4Cr1kAJaiuoG8jtJMpcDdC1fx+XvFkJL4zpxfoh1ydxIzs5Nulz584RTt17IKe3gmsIkRG8WVndZMTPljlqwO3ly2NzgclhZeyZgpogK/QSqaWwagprs5IobyXQAxovkkhG08qIvwmXH/ULI5DSFxYUGAtaslpQ1Zv54iCuZYWCtrU85BXBt3y+eTvsmncbmK4cjxzmQsN50uNWWJz5qxzK6mWq//iHAmy1t2w+ql/HLs2Up2wp6o4mGXqAaxQPMldNPet+fPK3HWTDEbGzdKyX0m7wpFUuxMTcxLUU08V+sS1Ukvd2fToWVn8AZMsPzEE9IfjHKM/64vYq+2xJa4VoU7gUDAQvdfe7hdDLZq1Zn5N7TTZ2BzC65TX2Sghy/S2lb96t8MkmTJkaDAsuGwR4CnN8+JcChwVeE+86zDeEqByEf9RAHHT6AcObV9c+OdREzcUldNKAVeE2UNFm3WXr1aEUOCkaVFr63KoAU2M2PyIf6dvwSjm5uHBgT7FMwU6mju1U3/vadhUrSc+2JxP0pRWJ5Str9vUC+2pi0T1On2EzTHyZBwZcXiUdZ8BV+EBFQ0OR7jd27xrqhArosfDACoWwovDQ51pDZfWI5A0vz6G8gdPKav6/4J/MDK0iFObZqgNNz0eGC5ccltBw/YadwYFgoX4BMhj6Bh8MO24DiJvLrW4csM3WOubqHfEBMhDGrIzsU4Ofg8eASx+nf5lYcPbGZcM6JmCi1vILPq1tK/1rK/UkIHC1XjmX4iPlDs0wsbF79tejz0FqcqlSIiZQBSQ2ExfrrMk5/8zw0MPuTYODAn+/lZgun+e8qca3Yn9X1rxzO+AT7QPsqAxeLbQ5rRKRWyJAKAyqExf7Tm0c9d2uOonFuh+yotj3fRluRZ9fjWU/naifAh3h/8jVZPIUAXnhqQjQ7iad4Et8fprtdFBRUQ7sUBmh7jOVzpY6R0EMv0n7deK5LBbHhARabNpbIyhtQFu0XIBnB1YItglJd3V90YYdjFCD+hwQYzQzjyLhu2gYbi8InMI/rxwOSk9JBGMbP6CpYFHrdaNcgH2hSa1/di1ZCTMfABpQ3y6/TwPlqxgV3/YpkbzYq+VcmcWxFstdJ+6rhai+ROMdaBD+0ySNOo12hFCwBEFPd7iWmHAihs+ljoFBGHPsyePVAeBZ16VZ68WTiRnSRPQuSXQ/ZsXG5XWDZgkECM0IkIIWBMt6ah/13Co6nmrKGyClBELB0zYPeaoc6X9OPaQBdjXj6TSIs9DCJiuuMOdFQImy9hSDwt+RkiWZlLLJ1+W0u/HTBVtnCb2VRIeqAAmP1+eRprnYa1gWci8tZH9dGcc3usXOQYuP058Bo2b2+TLhlh/pPMl1PG1lOkX52xkaCg9Jw8b7gFuvQ5lCKMFOcH/5vD7laDDtLNdt+XJenRO0QHtmuk9seoKK5wUl6X8IrQq6CsnEx5xzeFMH/Q3Lc29B6VnIpLKZ3QtMlhybt0H7hjWMV+8ywgnNVpW9vkLg+1gvoUS8/Djyl5gTuPb2y8L1HGfQfRsXIsTje2g3YHU2dFpegIZFh20UhMKawOMAhMYFFtm2xRNqv3Njlh76VVqnBjLc13xbJiNohMCot7Tj6+XHd/QPsEIrQ+HTacPVsUpRldxI33Gy9R8Rbm+6SoBsFWE6x52g0qWHvr+vE/+dI3PtgRZLfIfWBOc39Ujf2go8mSxV/JF1ftCaWv5sAf9+rkhoI/P9U37QgQZwD0204ZHqVjM7ywuW2LbxZaZTN98zlcZDdAQjcEEPd8qF/boxGIYLT+3wwDL1T7vbUOSZZH375/0WoGiBB/cI4w/z8cVPG1Ri/Y0814bqIh7fvaXChlb8Wf8TJTHiLokxfE5O0x4IxcJ/dAxeyEzRUUek17rrrzgeA/DPUE6hc4TCWxyuD1SReEYhFpJYtJtJJSvOkZwlanBTkyFVTW9V0quB2m+W8fm5rX9vw8Sj12mh4lF7uJM5O3rFtApidDM/sT0544IBTRMq6T9bEC/toBRotCcrA4D8wPxj3J+4hqBPMN9YVmhOgIfjRs3DDa0D38f6m1yU8t7Ju4wEGhADzgxlJnp3lKhL64A/giljtQY8XpvyVZvaAdbB+WeAvvYZTtxIkN0ebZHxnYjE/ozp9l4bgS8rv61fRfhP4xiNEXDM+jwjcKkE02lmZT9BGLZKbe0MIKZEoydYLVTQgj0p8hdU0pIbzLFbsbZxz0CHinHOb3jPtfitlp/rbQEwusve4z7OwKOJJZT93lD+2jKSz9BusDjJiWfpSu6w0A/GTSC2LYDjyOrwWHhB6xPbYsEEHrIMSPmDXzVF1nDLyr9ZXWj44YN9G93vJu2xkTS9VfhkRWWhIyglF2rSEDu9LYQQsJe/7hHrwYLVRQyMK32uwE/kXN3tFNLipo87yXYurI0LBFeyabHPiBCkY2dg2lvNNW+fQ1Bz3DXqGyE6z7mvTBwiHZlwCGP0T9zZaqwWzleWw0lvqLrvuGfdl2kaVCbn+EfbzRoxh1t2U3BE6n6zYClUvIx+6P0zHHVAnnPt4aZxQDd+2rEChoEdkpmiIibWEm8CQqo7RUx25p1QBBaXjYG4Z9YIyBDGSCKoLhODOXxFNcITmQrVaXKto5XLQNjtzdzSdQ9CrgJHEe8ZbfehUPr2YOXR/OoKO1oXHP4CHEzKeK/F+X0ORvxzP229Zc9Zo868+qo13esBozPk+i0WLgj2d6SvINn82HePJyyOpZTUIoNRZdWe/nxTahh3pZxKswYjQIX9BQ0csvo4mFTXaz+cQNiA3V+KfZ1emKKneYOC9+YV6LS5iDxi/OQPSlE1aurzOdO9ZzdHxd6NLyHNT+DSrjOtuduCzkC47N4ESF2H/UUHBNQf7vnqe3lKKoz5rwcj7FAUOfJQum+PzN4ij58rMocjeP562TCrFpSXRRk74iextlWxYpD68Pt+DPtVcHiriwj1kmoBspwuDRa/2Au5sZGy3SHA+ejedZU7ePKjIgN4J2HGMJ2tqADynVy6nV1tpaqcjFFJeFjbKHQL0sX6Sf92OguZP00r8V7kvg2xk2OuJXeONIBWuq8qSz7PoxUc/6u3/hml5fHC+0plj+oL4wH2y/NIXY+08blF7pa7NTiy0dd0a+H0VjJ0TYNeyZ2iiZVHq9AsHQ0YGQvD0GaAUbo/EXgxSWrzDxaZw8lpAhfY4vyY3MaGKv3Fcv8Dhg5rHTBRtkuFO0zMbXrxqXLHQkhns6q0Xsi3S5+JmJi1XeoGlWZHKAXvBSZNDwDVOcLVgSsNY1dazAaPV07HocMJehT9yZXnJmW4wkm45xABshJfIwlunY9+Fm+y+mztL6R7miXa/79mj0RHR633V8jTVWX6KPITHkpYNwBpCkPr8M4UjeiSvZ2yNgbwEymzdhYJQ+U9csTaNN5SOw2DNNvTr03zT3T8W4WjZyjb956aQCM2ffN43WyhzdkqYS0itLKqV/9v3bznC3s2hUEj7b5BTwLNZw/CapE+ShUk4JMxRcn2D34TJGfNBnFrsIRJMnsX4S3yxpDQnGzL/xFQ9gQsoAVyhcYrN0gj5AAjPF3rNQ7ktiVvMyszOyFagaugD7SP8e1UOP1F3sk7dvqAAF/hwoCeAA5d9O29EjPl9jyI63l/CYAqoMVsik6E71N54fuO8rL+WVVoNwqJcQloufcuXTzx1rIMW8h4KMcUpZrBDnwJ95HmZn0aXXNlJ/M8uAswPYlDAyK+unKzGgKtETLVa5Rd2IPtrY+XfUn3os5QduF/jrqiV+2emAqCzf/M6TBrAX371HSAQHIQAWu+hmVhTHNXFh+DCJbFoLJwF+rNDtXbdgU5WDMwBeM8HQjCtG6PYODNt94v6zYR84FArHHurcTMNiIyT1/nxToukHS5RsCrsBH/4Fv5P5EzHWcrSJgSojYkTwddugulDljA5N6Rb1fT9Yz8oOwOeEs15wBFtwIaklcYh2sUxOEC5RFifxJsnr047G1AvjjIKpdABrrM0WAsBo5d8kKSMwVGh3UwmWmshXtHKegAXt7pn2rtA8MJrtmlji0JsSL1YxSlyr/fkTLCoDqqJwQssUhay1XWl17qfYkhFR2vuuVlxmXVPeAqWOULJrA+cyL47k9UF2orU5KbfO8xZCuIaPe3sBB9EKWo3SPcgN9aLfMtFFOQ89dGTM1bDjDVfaxFpPC612fe6EcpyQ//YmKTbtZaMrIUrZrBK2HtGXvJgKNBI1bDkRTe3vhhPLUeRLm4rmQ9BFeVI/cmYYhuKDA8dW1xrR8afyf3AF9OOcQAtyrk1D6z/LihWXvDLVQtawVFnJIbhi+voRAH5ra8Yx0illM7cRYusl1QaC2MthvczlsIx3cNu6QWkEwfgv6b7JBqDJo9kx445H0o0Zvyg95WTQt8NEeS4VtTZ+Sh93xW8nT5t+yYllRx8JxA4H/sJoDmXnsndGGT5haVJ+mxRrRt5ctSDPYBnWa46HnIqvfe25Badl6/qivvz0YxQ5AbUa5tKKDeS2WYR6OcO65Ks0DWNbNDWTzsvIYYnjEBdkMkEc9Sb/2MBTHFlyHEW/pgfwqsopv+9/OFElta8ZLR1ue9iL78Ne/VMfPzImqc81bUuvUT8dPsTTwhsPW4sBe8wqEGabFOJLi4vWrB8j+G+obaAtGmmreRTU3JOcuDCD4qaiRXiSvKNMTqSYUk9E8vAm1hR3QGGhwY90f4JDbce1YY71gIZxl5Ztn+u9kocByJ2VfYUt2151wtqf2kQMbgicr18sGJa2UQVyG0H4HFDZIMid8xtIPnZ3KnaQk/yqEUNy/0kyGMaXiEyZNzGWdK1uZMrIlckjcQtBLMc8+Ajjb+W8SzWrCb2dzszujooHAHFjg3QZrYwFvgoaAOuX1Rd5VGSVwQu9oXI+ZDNgAt1RHzwfMh5FjhVviGrwYlA5KhdUbGHEMsKr2ILHnNnJlA9KiRJfu/lLkgbt9bN2kXo3K63Kk+KYOZQ1i6qQK2x1V8Y5V6qgCHtRj/LmCWHX1L+mefjbV6sCg/MXXjjqeB8xVaxsiXHthl4cpVJJMzf2eH9U6kLvMiybzDm086m3wahrfREGz9YiQtSGcwnkpx7n6435CUu7YeLJLbhScayUMeOM7THOAF3uk3/KfoXXafDQKyutr3IOkWjcfTaP35XuDAhcYOL+GWGeB4QW3Ru3TEvhG3+CWSzWIodyq3npk9nwXA+Ism6ob4/LYKAP7pYbR+UESi7dlmFC9LjJGjEez7aUC4sK19iIc2GpJNFELz1Rgw6EbK5CVHiYWIaUasNJG1rjuBj09EOE+a0O06JsTWOgVjckOE0yQ09xOJsvcI2I3023Edlo4HSkjFSbVfjoQqHvXDJYmuutGot76ioYpkta+01ErX9QK7WV3/XI4s6h75U8O7Xx/DkW1YnRTTSRKOuV2doSRQsBIkiKx0JsK0CROlz4vhhNZ6JEhgkchyyxgxKAP0P93WzQ1E1quhXLFA73pD4mBz+1bQj37NE//dT5yjHErslDACd0UqgfYM0iaSf40AP3Lo8ctcTfluzq7IPDGHZ07aJoB8THruZD6RIVCtW5xaEiZCKyk+TBZT1xMKDRFgVsliVZr3ngPgqsb+ak5qhR/E47SouoYpAJuK7VkikIrwl/UXxVAUaDBrTvzax7gNEAkBiCeTvCiklFiSER9sY62dw46eDPPf40XmVXnytcCrQPzKLgnUJhH6QMnj9WCFpEMF84dHIINq7Q6hY7S3LHDLvE42IWP+xjId/WiAzpZ4WgOvPq36LD65j8F35SmNNBZlVE4Qe4olSPBTlGuDwxcSwT6XIl4L6BD1lwy8FUt/qjlvFTPMLPXEYakmSDlAUiMX07cyb4Q3HremqyoNK8tY+zVJlJ6yZFywAzlAW2ZvEQY4nSjcr39fTKcFjWFvrHgIxa6jBubGIdubQz/zx2/2dzzXkn7BGCPMgBKVGtXaoWMhzR7AklrLg9MmcXA1wV2wfpNiNcZaItj1ClHhA6GmV00HPYPvgYiyDoySed/bo1t8RsBT3fX4Ytmzlgq8dvCJ0iALxG2+Ls7Kx/FnHGq+SsZs3a+NAbPu7DE2xe/Xff4SpkUhxKlbprba5/S3hdeBXTeaOyDpV9tP78YnhgKsPO+x5Wbpm0tIRMQmbdu4oqIkfUCwQNMVi/gZwCv+f7JC1/nn2dcPdKYXBXMoMqm0yj0lhBsF1Xyfm61dKWhJthVtXqSLqsk+XFiNmzn+5p/pCsg9/DuLcwyjypEwYEuxND/J27I6BHVQTMhDIxCQDkMg4misPtEKFw2x07EUrh5uNEuLILkbHxiWIGyhj7FFFfQ/1cZtCqi/XJHJ9Y+XswJyEs61zQ4L4JsAU2y2BB5kLvEGoHcb9YBsLYSTLLQv1sF8WJhXX833ORX9hDE+kWKEerJTuoM9RXzOzjkLcVVHRj2r6kdxosZ182KSZ2Y1W9hR47C3wiI/vnPr3XitZ+8KycciJlwMQdf51fMpBoJt9Y3p0ZWDBkd3ArZWSxaC+zoY5eyyKlA6AaaBCWXvn4Z2aXDAkfRQZhC9cbegVlyY1r+oykE/Z5+Z6GmOZq//uJm/wewL5V91Ca2PQvw8W36Ij5Yqbj5UdG5iYfGoiCiRwrcUB5BC9u1puL3GaqRVbh23us++qVxYM4B6Fu5wDyC8Lz7lIx+jNfT/UHGqeZoao8b+H0mYb/K/oQVOzuJ0MKYLgqXQrTB8Drb4kYLc48BOq1LAaejawj9MnMhMrtRDDzwmfiarxXtVbFbpIjhdAWUMB7PGkG6TtmXgVU4XiaUWKKcHMRMQWKdKBIxIqBsRBM57i8vDo1MgniIyBH/xpLO/XepUci5j0aeVJNmFg+slQ4NFg7z0Oy+Ung/EUNKXva8qpSFXXwB/DT9UYsdcEqlnqUpbj0E9x6Y/Rr8kO01qcxinbtNXjknnwHwXgIN/fok/g+0lQ1xnxy1ZlzkYzTfG7g0txMuOg8i0J46fiRpn/ckBN9pjXAkpy3jYTgU1m7v0f1Wn3uqoLHh8astoq/1IjRHSCmDW7kNOF9ROprCNaJy0TNijSKqdUI9tOWivCBwLwFmquRz8gEn5EsKOcmtiOLm5lhkFbMtQZG/gt8h2BdR72SIiw3RlBDHZ8WY6zyhpIx94r8nrIe9Gva+yboZJdUq2LpooekIRhF899MEPIbNHT+AGnHfm69x8gZB47t+Wjn9+1mFH99FltJWyg7VCKLQbx+TeufZNQYwQ9hy0epiCt27BSYAs/O9FyWkQr5mCCTFtaysT7p3I/uNgnu3jm6wl20j5Cx0aMaMesGt6kPpGOF7IFFdKl1fpUjTIW6NeY/Pwt3foT8mwsDvNq5u+rnuCysimracyMSZz7+0YyZVfXCfJBvPlHbOoYrA+JGo9jMB17BYmFW1XBLeekr6Xe1mKEVW10CfV1vmy81/zNH1+j0lmHrjftAFTMU4u/m7aiXqeUeusO/K3tQ6aFFRdlq77YxpPyvGV8c91S6Vf484nUDfRssFmsxvyPqXpheZbWC8PIMvQwLyyFGl1uX6ysZ54JKpKbwU8zfKm9/t2zAoKdy0uF/JcxMlcAMCtZwcyM3goztXDJvTZtpOtzuad4+xBoLxbP0mN9uekTw+EQkvalriidMKV0kwSWgtWGSW/f6+qwBRn9NmTNUzAxtVtOw7LrtUPMGDRuK1cSiDJeqpKygNbv48o4yUiv3aPH+FIJg7+HSJdsdQyczgds/cDZuncCU1RP8BgyjZP5UCQD6C4CtnkcpvwL8Js1Qd0xZWntTKg0nlY8L65iZn6CbdvxyeFdtYB+/VPOamHjmxa9Uz8x9U/95p/fmTGiJSp5Sgw66Emq0nWPJ4zjRX4G2GzvdoFOWWqq9sD5dQYo/DtJGvhjgMV3IFS0GTYTzb8aai9do1fTX0Z6+1/B6mIEzZQAhRrztcnvqS5dSGdd8qBXGHAhncfOv+j4kNYfp+kvssS648JH2PDrIZKUno51ipk9++4HGV76JIHb2X0o9UhKIrpne2pRpNnEDBEn+8F/fXsjUSr5AcBhGBilnOwcx8wFaolVnb/Sahv3zP+e8ys75i9lGOpnLdFULOjbAYSfouGhm5Xvip32vW4b96rsOJWpob/tqd2pj8vrkRzuSEUkfDvX4v/JJV91MHkYlNPEodYbtp/xceHJB5CFsNh5aOPH9NV9bGC8A5iR/cubSwexOMHtensX9+1EPUlr2aci+qn4EegJbx2hZaQ/QXVJZ9CqPBtWo/wEabBaRhE57kNDEOQz9pVmrIaShLzyBTy7joGYYuH2TTqIqiRMyr/7D3GXRRx7lkhOtdOZ/eJ6M2V0EPeM0NqqoGzRqibyRIlrABJSCPtFvzVYA4ASWJV2p6Vafy2ZyMbrCYNKA7UOpasJ+jCbTqypfg/wdTYieyUYoRn+ehVj9fUQXANkxiczplFUa7OPElN2DR7jYwdBRa5P5W4KLG3a0NEalA9Y1RasOyGu91zEeNH0dmimsCXi8pW1jhNHAPUB4+Gwq8ycjSdKTKE0jbmkt4D4e+t7XhiJPWrcjEPOwpD+ZdpTgav668a4Nb6sCGmblht5pXL4CvlFtLezWKaagj07HoHVxffxEhrIdOA8FdSTVoJhYcMvL2WAmdCpbo9hgzFTQEn9AhBIVNW+TY4u4M36f/WYXgikmOsd9TCQXOz8tb5LP/3Q2nYhcLpd8xOkAmFMfANC8mtPr8R92nzhF9s6rpPSdKJfUri97iH8tM6xiOv7WHpTPOdMOW6lIZW5tVH+QfqouZg82Joi4mJgkqjS7L7r936l5xjIp/qzPiq9wpgxOgRa5kWiqSiYoF187cVhrt0KXVeSm/8/demwr+giM4MMXJOFgGHFixEsfMl3omMgPMuxYLEQutFw9D/b9foq6sC8vVukbMuPqyu1DBsYEWDnloLpg6wKbzlyqhqYVrXRoLr+wEltgradIo73jEqNp1JbDg0uTa28aIJpDFwbSLIXzZPPcgvYvdNpF+No0cQkpg6PMhpvJ6SCQu04BD6vED8UIZ6bo73GAtgfHjOiAnzC9L261l09FCT2GhNI5b7jn03E5TWuVpUP+saTqVbX9sdvRcipmPNWM+2lkUd3WMKK/cuUG8oiyYnz2Cd508bHSZXBj4/0Az5aF8i4kJz3rZR5Oh9jd/lrwLtgmy5g7ro9s3dnj+VPSHml6I1aQdq19V7XjGjS5qA40sl8bv9ecYHFsVdcaPzRJKspBzCQ58GuA5/cT2J7vJwUHFmX5tpMU5e8073LduGc3hmP2Dq0SGFZKnqj2OXB8/fWsOIrXD3fw4O1mfkIWbqKLIDtMSqTUkABsbQGGSfHHNXDWESTw2zeGpnaQM3opDFW8Dc+BbnLra/x6t/wdZEI/4M/ySxKOlbl5IV1goBeKVUsvG19PfRokDkXtOGpV9z50xSq4nqQHsxm5H1w5ZxexbLtzFlvFw8ST7uEDnEPVE4EsJTmDopincSs0oSxnDg+PblZ9QGD1N2ej3k26XYYMFNtKMs1svrNrCavDDktwGBAhmo3mvNEM/URRODOPhUcgU72DqNaJZ9Ykzn50QHhFj0O0cc1gUmSigd9IyL35K+3OSglV5OL45GE95CflEO4hImEkUR7JAtcXo40mzPwoKv/KzL6NyW3unmRdNSprQ280WSiGvDR56b+JKEeWVPjZfWhwRIYxHdvNATJLkajEbabz9OIbK4v+UaWorng6ZCcAeTEEoVbOhNA2wP143JJnyvwOtg9+t7Fa4mG9tXr+vxCQ+gcVHfZ3XQ1wvbzAxkHUkZ81sQX4hiJFkjUbvYrSGTjZGejztFfus/X7kelzN078nmog9Ack/pQmVFU2LZNN9glclyrUsy5V7P3VHOuttvAV34rMPLt3h5AORoMYoBkD4nTvMZyTFR4V9XkS3AENSwtr5taB6yOSZvzZYCyBBqYUJl7MNTwKlDLvtVilG8frMtdOAKwsKDJft7xfZjukh5V95ll0m4joc8cPSke4ev6Q79SjZJj72lzu1In7h0oyIAXAQ+rI/RA5jkVoa0tzrimOlhjcBnd+7L9EYdUL1nq9VaXJRZb0wBfHVZnpHCojGAiAiY7MsjWLPnlf0c0QBKoN5hloKc1148zRGpEFHn42I+5qpPFe/ub4vEuuXujAho7Y59K5hJFaZipUTzkiMyj4hjvnJ7KILrD8yHFBd4xGCxiBZLdyJL+r60XcwrPJHJZhoEAVSZbAy1Kjfry7eQuDw8lr0wKG7aHIfu9oy18hepv33lWhl/IU3a1z0RuECtT4we11SCd8XE5p7YPu/3HvLKM6M2tB0MiRYIdWeMwqLUFMgKvnUl5uu+5YiiXmUXanooISaFr4P5IRWxLmT0EG2OF8HIHVyU16swFa6EihCGp+Qov/5vtKDk6jqXqm6Xh3JG8AlZe84RRisv86YlhKDDaukHKjjpHKo7gcXcpjfP80vi9ziwLTOLJeOFdlIRXi2LOCE+E8nt2XfmHu6QJpXuwCLcvucyeMCk1YVUnaZKzxBEshyRwtzItFT1+rArmFMcOf15q5JzP+P3/AciZvITjaZzjMZCeL91tj9RMnPqgT0OBM/FXNtCixQ9EAic+qHaC9oWLZvntKa+phBbllJrhDm1I8m9dG7Cr+uDdVEITCZNa6ZHcDU/wfIsZCtDY19Thojtru9JsfEaz4/VQcekPSgBAWc+oAYpYz8J7Z1aQfRFU5PuuIe+ptM0sxXq2riiC5prgv3y1+/R+1qHT0LEfUZkuvBeGCi9xB1VSKP1xBQ91HNVMwy7Mx466a8hZzKNvXTs5yDm73WWhIMm5PaTYfKUL5Ld6GDCOSxAa1ngEVUd4dBHd26pgnLlI9ywvx0C19I14Dj9ZyB8KQ5qZo47HHGrPlZZHBmcfoVG/dxRfjAg8HxlL1MhroKBvGq1L0U629v/PcJ5UKA0oAoBct/uOfZj2w+LXr/2kogumuMwPObUtmE4fDv/hid9UYv2Jy3ueBnLsfwLwRK8ANzMZwvaOdY6QeflcbdCjzhnkZqDkvw9E0SgK9bf7v0DUY8zb1gc6XXyBdf4HaQCm45priha4PxUKnPaC/H79Fc78rsJdM8fLLdu5EHDDO/517slrsOpBo9CVDuxl8Ds71vJjOv0y1YVzh9jx16b0XwdGFzA17d6Wd755TdUoGibfEGS0Ggu/Y7Am8H/F8AzXx5tDYIjMIOD8zCABsmPLAEzAKUzsZSTjd6MirGJUkeD+RNjw99ihUQMp2Lzvj5ONhn9BphvXvmb1216t4GUIuEO5i8XmdUbmeGBGbEH/RxS7XoiKxjOJaeiekZwWMIboIrmHYtbEWYw+mQsfy7/hs/pKZOkHvoGLHD/9hqNoSwHB1qyv9fh72R6nwj08mh8Gt6pSYRw3SzgW4TPVwmtXcWkE6nmeltMf066QNX2W8hVUoF2jdCuSeB/rsJqVwmMoVQo5b47aKoudk9LhiN4t24BBhuXeG2Ut9kkfqcEIAdvRbDhtChI9W3V5tnz/OzxvrkMN0T67jTXVgQYA6xLcZvRuXFhjNsnoWbX9QUaUSqUXvlq8JXzdP12B2dDxr/cUo/98IqdR4xiPOjLlmGzG0IDki55k2/o/cLF0DUq25kcdnht+pBuNSSMYY4hg08jK5baBX11k8mpeGxDWfbg3iK/8DAEmuk8CrqescITdk2wUtuzg3XU0Arcb/Mtv8FM2n+0E1bYH700eWgGKeVOzoYWpxyMnUAGRnuWsRX4uWJLde4c87QHQE23enn/a2KowFu7GjbyXelQgPTjQ1Q9dpLjZr9VG6ZA2XKQ80Bw2MeQ7EKdvDJDviGOhOD0c77g+gnvIkEO/BOhdKy09rIQbtDmQzrXfV2DDytvIk/bcU6hybXTDY9B+2WqqJEvxgSAWSuUT6zhxem3CiJQRnuMoVCIpwA9G0iWgPTqhb3+EZPe593f19pJx/glxlBcvEuDicGQRn/lgjVN+zu8qNUQUTNgEdsisO6Rl7WGl1UAyoHwPExQChq3gTnJGcvUrDiDvmDzf6CdXU2LilkDHIihqGa/wUsFowyZKCbPTSvTqg0ibesw0Ici5efbJrguzJTuusIzhT9SpgiAqhUQeyvMrkQJpZ1XdxYe8DS9e7M6nvpLde8htIDp8YMVB/AMHY74nqyf0ZQZWvDR82x4duScQd+eKX2BC1V1hbagjgfdG9S6XdqXJIuueo+V9Qo78vLUBpnCwDaLghhmpauflgD43irYkRfZcV3V9EAkDJdgLyEoAHrrfnrmkXPhC2A+T+L8ZWRNE1rD8K6e8hc6gH5QCOYLDupoCkWRkri5D3BFpEkElDcOsQfb6Vl1j0c4Qi3FJDYTuIhh69nMHZsSK3waedZlgjeggiVfR23AxwIQ8M3WILlZvcIxcY4YVl9au8fnMLQriMsZenH8HQv86PYRVZrBTUCeErqa7PYBZuhkVAtTwc1VAnWwkeVDmk2CxJoY7zaiRX1c/PzphmXRxVecwnyRbVVgqVbS8d6fICTGFnM5lk/tRR7GHe09XklcYwIGqb6xSx+bKwhsILRi+2Jkt4tk6X3LgDvXWKC/R1w7cyvve0eZfcZQk9eScA70UKrF5818laNuV0yn2+PLoJumRLGr991UmjWRlG/m8Sp7UJgOoQYH3H2qsBdb2oGX6u96tId8onAQT3U7LeflNy9YMt3HTMDdGKFwBwri2elBkG24XX4nqi+yqJthz116KDsTG2NWE+15lV26h9zafQRkVwVJ9v0QmFoNB9kRKW6Zz7iFtz10qe2EEbjnuqaLugCTJmST4ULzhdE8/RCD2gNQFH4rcnOphzfRoQdUE5jkaOuzeGQNPG1OC7/55Q6cwscxg+qrc5xDSAq+8a0NjG/OnBy4t25vcSRavHfMcmIOyEshsAy7NV3dgkjOjxF3zi3cfILwGIAKWj73477KJN8q1V+NpquHnl4JvbKpCwi718FPAelvv0C9EoT2bspqaSMi6G9H6gNJ++yIUywZCpm8fPHd1LBLNzRIAh5r3Sj3wW5PKjaOO+hhmx64O593FjFoB07C7yjY5tMTfA8BrP4fLT4g3MEBT+55bcQdSf/ps9MxRe9DsOjhTySkHduTnjRU4kJUEkhoaJaRlWXNo9//5yz586swqck7DxmODFcrvU9jcgO4abOoNmtLDB/VU+s/G+xSOP5n9FVU/7wkJP28Bzct90fNmRNGe02QP2aT3hqGy5cKWUFlqho/pEjjOlPWo8p5rSMXeq2D5PtpJtBot5KhPjgrCF3ZbSkpDJ4KvbSw8tI9MX3e3+EKL2sbgyjDBv7ZRO0wDq910X1fqc5OS23D/vnvul/DfbeCTt7pEmXlIeoFW00aoNVvYE3JSSLrZRKTczPcznX5pr9o6NoRxMoTqRDnZoNiXyUsqqifspseEyc3z36w/KahIv3lklyWCvFwKijkXWXdDRjpBzUp6g5VOOaLaY5YcUE0Brc7wupzpDxY9YPeh5nCv+ZK07Z8hu7/nCBpXteUIXZOA2/kz92L4r1NtdEn+k7M8Rj0lHcXUHSfoNMC3hbAc33Fo9rYC39iJPy5xjGT68a3n+c+NlAv2No1RC1cIIGsOsyTGDCRW75mgfk1hGD4gs26K5zD0K83vzVNkgFeEmNEYjz9haljMfooV5I8u3UhJEgIH/DRL/vMyngt8pt7ltZAjiYZUte6iX7edQ7hLC8bY21jD1U45jdKUftiQmY621ziBXRVFBAGFmBnpeREGo72il9v/1+7o4PkNy6/4Arc756zQ1DqtsZl9lmsvDTneQnE1fNzamSUqDg9tn1rRnV9+RKGhfikGYGdh6+eJ8drHtGN2ImwOdFnKHfW+A20Ge5eRFlSZ1T36kKbImd8GVaY3W1M4vY/dKcBaEau7Tlp8LKblmQxgc9wmvL/N0gBDt8ct3XA1ktczFGl6kyBxq6LXJ/NzwH1lyLOZbD8+IXql8spLyYFgPTcDzmG42h3VrGzqEg1nGLZzDb/FImL869yh24IJPrN7l5b0Vl5RzCMdKPrIpgG5kihN3s6m5nBbDR0e3SYTF7UNAEnCp2kkymT0CmZsh2ANsqYzU6LPJxpJSpKrUighgz5ASU1DzH79KpbVmaT16CViTW87uHwfZxg2V95QVG+mQZ9+WsS5y5HauxbwdBI3+9wnqfV0pVLJfS2uyGvBdI3mI33tXRPMmj8fbR5i2LjF2mtw0GgpMRO0QHY9npAYICIulJs7K+gQJt+at7JA8VJLD4eWcWdMiTD/UIz4lZy49P8y/qrZdKNltHyoZGW79HwvVQeNK9VTjDh+0V2wbJBWkoKwzwfro96RfyS8GVfXbUOMx5mo+RGDD4jVMLMM8l9C4Mqg9hKVD9eHa08BuY+MeH57lA+RA3yj/CD4iKkSSCDrXk0sw4NyVC+0n+CAGxecvzz3OobiHVParzTEtyxrZsjpgkBrwdJ4g6gxb00fqczTkOf3COiRPi3drp6W7uoP5sMt7mZcOnpGzuB06rm+dzgmPP2X5LqOHghNvp2dt8Gz+giSN78tWtH3GXnpgw+IGQ44up7D6PCjF1bW/51Bp9sQ+7SMMLuliJ6S2+/HYtTpvCZgJdtCT4eDjc8ekDJp6PQWdxg2YsWe1odF/HAv/0XJyOfaxAI11UQjEiYDGX2WYRbZMVeuhHUMuI5LCH3KY1zDOMTVX0ALJy0abQ61PUwapQgoNsjzLDbj4kPK7t/7HJz+phqHckapBff6hCESGvOdaOyu7WhQyfhPP+UiP/lT+njgN8OHHeg+yLmdRTdCqnOxQxABdvtdIDwGPg8LW/jUUFvVJPpa6obgavdUmAvLfToOxXURL8cRcraiyBYH59fQy0AjJ0/Al5+QMNC4rCXOeHGjLjMCmAg29zkZw3Z1nRM6TFi3RgOzyqRdLtga0Bhmg/qWFOaKUzWI3kwhnNi3RjBs4h84ErHboaqwVTp46K3XpfAEm6m2ZHJXguj9EQaD8Lntw3vpsBg7nahMMhap/+LmcRcjxxDdsJLVLcnhqdm+5HmPzfp4UdqC1NLRl5j4Q93b0QfCUZZaAdJYJL5xhyFt/T4+niYbKNP4gmGiYlM4lt7mLHlUMZr+lMv5p7e/9fODtyGkhkyRiIivNasCM2R9EFkK/Ax4N0PYe1yHlLCS2vGfBVujqkKbed+IhVeCqkA25tBnDDVR68hM8oBTNIdS7knI5H/YFlIOc12lzJl0QKpM368MUbqyR7xnBi2o6fcWceGap/jVfAUFZUKthNy1opw/ycgcaOf3oCcAhd97uvt0/ty08+SulaDQoWQxRu41NjBYUMlXvms60/e8W7agqPDpS2mf85fYsmIiq4PAWqEahBbzsBmevGF1svhJwWXbIcVbXIEjk/79KKJNKvwOGvsvr97L//OIYsjIU/6dd5BLhaLQKZKSiAIB3zYxMJ0IkQ6Mg1pL4+kSrISqmGLNnh4UBfEZSuxnSe26PWn9Mj3ibrcx8c7gdsewsly437KGPvOCVWqZxsqKD0ywYFHAsTfL5Mu93n4Zxg5zBulNmeyG4onPuJZdKIxTq5CePuSC2NqOicUNS/O8eBeY/L6G8/ESZgJVpUfYVQy0A4zEOl5qYnDFsOaiqe4uJhQLmi6Ls9c6yFwYmfoaZ5AzvZaBbl1CU3oKb31yk59QGfNqIWVWChykNs4zcWCpK2bjcp0xPw3YzMFzh+1mEgMngva53kMTVZCebsRDVToB0mgtMD4PE7SjRUEIb0D6BEN9cHbAItkAj+E4ko/7bMwhW5aNKQ8Gsgynq82LMSOlDLyOmA3RdbY4J9AZ4xw/1OKX0xl2jsO0E0C0Y52pdyndhg8nKBJ80Wn/0IuyleKVwte82t2m8BnS+ZWvE2RqJ9WFg6m2S/GwOgneCNbYxHmcUP9Uyuw9CvxdV2usYgHyWO0bZOO9oXPIiUefyCrGfxa/LRQhQSymfdEjcdt+KFD1O1IL8OM9UEYcofyLdpoatzKps1PsQGy57HVbLBSD+x2xbqA64DSZ7AzAiG45yyh1monUCaXdVLRE2ekd72KFyTZqaQDXqtSTenYk8+wGU+85rwvU67js7rzq08IhsZh1xScsC3BbrWjvCnNkFNUESLAVYH6JcTwWMrW4U4v+ehAx9L7uxafGd3whV4sHdeJvSAlkgrXC268nSrc7vamyB127M5Nc5dX+pX2O5+X4zYmTLo3uvLTNdkhAQFjqRY28oGb7W2d8fCs10Rt2WUuLNSeaJ3ACSIbGBnXdavX4y81M4vGfDU4WJHny3+FgxR1qbBNll+qIJbdQUTPzqolCck50t0NPgzt/G9QmJsDBtI+Kq43K98tjqF4wgbdOPgz/jtcTZEUW2nP1nmnRr7ty55DnelEMFtQDiq1RRh92SIIl/OG40BNjkeaPBTT2pY9mz7tZFekCiQkT5LFc9zw0CPugX8iCeUq1Bznf776TA+4yA9gex8sbyqqNIuuJNTRZVgPFsjlcI2vGSpYpn8r/0QDBZd1pe3P3vhIf089585Rnu8gmAhbwyadhAV8lCZb5q76me+nw7XDBEtf4kWTMZm7SZCfTYGgZVKqocZeuWeBdt+wPhukhB0fk2lm2qrIGqhxJ+2OrnKSddg/tZoy25t8Ifro7Nkc9Ysga/VUgqsiF2sY+mZh9Kx8gL5stW9ctZQ2zeLIJ/0uUWouN+Iefm9K+a0vZRZoYXQgJTv9dzluTjuOOTUJpt4MEgRwt9Xld2HVlrtIDGjt+18A6DqlKwZ3djnFqQ3FxaxANmM7z9eFicQINS3w2qIl3nP3tnI8wB/IVWWTvCDvKMvRlBqUYAkTSlXvibgZaKLOyaxaXdAExdeWYz4CR37FRy2OoykyMMdbN9KaOq+64wGD49Fl/oYF+//OPzw3tSCNy+IXY9+d86ebL7Xglg7prqkAAA96u1dO+2L8bphZfD1ndl6I7lKByOTa5c/z/d42mmA2Qimar/iWo8/JBFg8w8hx709KPcDSK1ojdgGKPkRlIY44FJfpK/XFFsEBUZGzJYxk+ufB89JFLHrkMDIOlPn5vxkMCib7eqdn7FKll/0XDdg0GNm/hTPTcHOF0nR9e420qt/Emg/oF8fC5CyTsPfKPTI1XCM03vr7ZjNw8Vz1ISOYk8k1mjQ6IMiJYJOhYcTKxmk0PWNNF/eI8S/FI41X5qkckoewLjNygg65dVD67ocjQObRNaclnisGfhYXMQ2Y+fLkEhHSYoo8CI2VUG+5jVbqoyOgJ6BSuxf9UwaioR++QAH4d5x3bnWY6dvS7azOxcASS2bOPtIHIPq1qwuThUvxNwHSEoZLkdq0MOuJJ5RGO5xwvQ5lpsXnVtJolS/PwUeHMk/gyM2DxjZ8Bw7VvVlzf7bs7tKkK2Q2xumDKb53Z7XeudwKcgO40RTZl4Bfk1Uc2eKVfFIKB9BJfZitTvYF8MEh7NhMLZYxyRZpBdc7g902e7BZBetUc74xoNJEHPW8Vep/EaVuw9sMWN/zSeqPe/8/xUCM0E6Z3SgJ3wPOWjNt/kIfz9lpi6szWGZ3pIyoYDaIl0KsetIbYwAU2G5qnymc4Se42qsVImgqbakRXuY3uykdb8zYhjKv9cMc+YTXPH+OwBv1BIYC6uwlwl4/rTuhaeJNPKovIgPZem8SzygRRojOTR0zKrhoKnW0hfp5j5ASBGkxchRwRMCuaPGssWNI7ip/KsjIG1JMBrepUHHbiLI204enuA0rH7FK03hoKTzhE1NLoOQrIxPj4E5Cv8Uz7D8s2NN6COdpsiAmYDCiHr6HXzx/9OHktIUr9eY85T7WbTc5SdjV1grKq9cp0E+WWPayI+hYFqfu1g71xkJL5mDjTIkrT8Qa0+t49MdOqRlMpmjvPIA7qplldZe4vbOIDzAFaekxAS4pHmQ+Hja4h/oyVlXTCQ8HyciX0Ik2oVsRud1zGkky/jUsuTwoks3u+CeBvOBuJ/3uZ3ownUUIyPvj4vWv78EZ0qkTkOFm/n3oE8xKmfrULY7ZF/ud5CRp8ECHNaM92oyBTdaX6F5qQYgiMTDVi5BlClbqLEc5h+nNyoCa/s49DsLxKz2Q5PMAoRNVJFHMCIdkK4ei0QTvSTqitHyIi2HDbrcGuRuGXZf5jbG0ZoTi0NAxghRotjtpMBrkhMOKOdN/bnaoO5NQQhpcHzwBX1nHPDzA0E6Voi20BPjiAHS6jI2mwY8GrogCxXSCmOUBHspjmo633ZnhQHBQP/2m+yPfWIh5HhQBxN+UriaKdD5MJQIwHrZ8wQ1/u0tZJwyc8tkuS10C/U6HNpuJfTvyL+96uJlaq2FEXbkxMaYdoOVRsB9DZuHT5pVYyALl6lrl6NRo3JTMihfQu58e7pDc3VU7teZpTMX8bUIwpanzjH3zCCoZ4ZuPSd/ArjTsSMI5pEtGTX1oWBdED4ginzRWPsp8fEjXF2tEA2lJzoNcOmGnYWzjg9FXm/ZA6D4XoEG35GtKq+O7Khz337WA4t8eMfYddkxMYDXCeBmHPcwPoFUE+38dvNpmolL9H7lT2RSuX0vjlhFujfwGIvgy5shLMF1izo2c6aNITyX9dTGOj76hqBLBVwVZXUnzbHH0AeY7t2xEk/SbiCnTIwOB4u8k7AAgbrl799I1x7ClMJtuT15oNU0MFA+pmifL3vZEU3NamlNOneyAJ1DS0NzFNMj0Z7CcrntDKAAmXJtmigMchWuI7ACdoNEfJWzRYwDQfGaNp1XYhihD291JSa31c5SzHkFtQFrXqeDyYwCb+lEPffn1jJZhlqk5XpGx0A1G+Xhv1jCIum6qyVYMvg9dvppmjUkCtphfhASfjDMz2Q4T4syy4UdIWftETKSF9kZs4gEU3xsL2bRebP0LSghv2fmTFnDnn8BT/LPYxTNQl2y4gBucY7Wq7I3eGWqgGZ7CZKh9JioArI8dkTahfr5MMTw1JJ4dsH0g2Qk4QFSm5ntc+IRh/lH0ei2WfKVTHtUZA9MiQNjWTYtTwt5H0LD3qcBpBrfQyGFSipw0QKUNI3HB2QcMr1e9UoYdnlN+HCTUa2vjtpp9aUMFbKEQ5dHGw7K4KImW6c/cNT2QMCSfbSEijn5Vb/wg83+vR7tmEuy+x3TBkcRMmfSvcJdYVKSDyOM/aeIqCYSNaPBZ8GHVHcMU+xiLWIRBZG7iwbCxSPZP1/+PgFDKDZlpXe5CL75T5nEbDEq7vnlL8U4bQb+/OkpuZXzX8c+y7iWAWVS74JUUx2OcCoNjNSJ5ZfiXJk6I/R43Xqt/NZ8nmIqSi15YcAblL6NFcUf7NHSS8XxxoceUyajwPxL3YC+wR8nvwMh0NoCW6omrXFz1UzfFCiqWQQCQNCQz/SNAzdFt+y5PuzFBBPbg5utkLeCYCvHjlgYmDPUHlWNXoVN2al7L21uOkF60/W15EQPl2qrsCz8yI9VbDMOjGJLl0QLDx6CG1tXJLvFM4dn7ahmHGCXjSK1MhDB26523yn5wYHQadmjmhYYS3Aq2NjhMo3RoZGT5CDee3y8bHqCC/G4c0B0QWMrNdrJgYIMQnESGQo2u9Z7jZC1ZwqKPOFQxaWibnaEcXkTVI5JQwNmHqGOFuuuq8B4QYMDmn+WAPNGpuxvHy/QttbSXfiB4SOyWktD0Vuy93hJe8fCyxCKXtMlMOAgxje7G4noYi25xtgDG/jHaQVxKTIiDcGlfusupb3NMwrwH30zCZXPKECQSZZARdmFgsyDa/LHLm5rTI6cfJ3w6aCjcSJ0kWvNr1Xl3gAndhiNnHiww9rs8AIuI4l6UDON0hGB3zdQ3nFOuwRUg5Mg5uWB82p1Hr9ww6c0C5z7Te78IYo8syNGmMa9vkI1UrS8hkKbCvPNYpKV1lXwG0AhYi2oJtdS8SaXkpvLmoGIKyWLRYIDWbP+Q33/YF5u4jO1QQFaYsufkcHRIp0E/ko+fcwUpaj4ZfAzxyNFNaYkc4neq+WPyqvMkQM9HdAI9ZdTIoslfy8v0BmnRitKZLN/fLD1PvRxQkPL7OrAqJz+HMN6LrOWwluO3o+/sUwEpmWPO6u5DiTkI4gS+H/4ReKNMoiPY2PNSW0btD/XaTRC1IEt5HDon3gA7uzUUah7FND43pJBfeorCbdMXpRQE3dcRJmwj0EZvWJ6L45jbHK8azAy4MLWn2CsA4XkDqAqCvmAGzia90cYA32MNRtI9/QN2wk3Ke4wSU8++b9bP7ufcaqkWfOdxJ919xAi5zPdvydN8llcMdXRqEuUuOm/6fEGXYnw1udLd8+xCxjZseRxmw81D2WrWTCrWRxj5t4h5qRlGSvoRKHdWIv+pdLomNr5NlPqy73ER/uIGr1/I5F5D0YC9c6/bKWS5rCZwW6/d9AbuaMABO9+pCHibAaAk/fm0MBp1G4A53WLVy0f3uuDAPev50Vvjs+4afgmDk52hjy9b0crtO/zKyTQv+vJNJ+ddevdrd1iyiZKyjWPQHsiteBC51bqOBS+ym8/eXYLyw2R/B7joA6zPE6PL94hT5+BeYgTBM8LP2yz1gqNDnptqjEtUFAsFLODjjQMf1PPJX3/A9cW31wuK8SEitIqUYb/fngBgNywpH2zsI6DWREeUUpIHhXPoFfoUyOBnAVsk0BfwoCJMaYPzl6WK8SRddPqHnwxFIHdeTbgzFyVNuHBBvGpX2lwKLQ0t64FhoRsHilbfoKpaTTUYeUnmGFQU8Y/UtboP8PdMd397zjRDryKVH2nbwwb/uPfEUCP8GdJ6l6izwO3SHsgLPCLJEiUnsQvmoINriiF1ZCdUYs6qHPhnrjFQCAI0lMwIT4dHGRddydd5IHktTsG0K7WHZs8em9WEq9deAOmhtRTT51zMamHbjSb35j5ktB4j/T2/SPFdfSt0SPPdZH9MTq/4vwulYCujoOtkkXuFavddW0PMUsOgHME7vaoWjGijCvL/5LIWkTg+1WgvMI/SU/pLRiNdPF/5YH/h6wkwHPYh6w88vUf8CT+H8xbHBIuTtXYPzRToJFjDvKJD0t7yqJZ4gn1WHFFhKjTe4xiL6eQ2HIR+3Ya0/Thvs0vcNmYt0WQwoqYIW+mmfmHWDfq4jMKNk4Zluj0Nlu03WTFQ8z+cwOESuQCz634NiZ1YQqeWtGBej7sQNq6Y9HdsSJANQ0ky8k551qT3F65Z1k1kqIz8E+rEbL7q3k2iJqshyK/EpGai2n9KR0/4BWaf1i36yjhftRim9SKjJj0h7AUjTSAb6zRNqt0rRxK4zzRnQOknMzEDglEIF+lwbnKO9oC5K7XM7lEmRqJxslmYTW0qrYNGiwcyfsEIjPxT5wYOgZ51RthbbkMtAPUp+4ht7yjhriWuOTau72DIXWXpMlyDY+IEnXt9gDa1e0ZPvXvmRDwIe97QYfWy4g07ix5Bl1FVum2NRWp7efATOG8zv8JjyL/+al8yUUySeAdjkk9Sr54zMlbcosVv/UYEnsEFyrRsTS/Tu7PUvQTVEq4X0hB0w9uMlOnEZ5ilZJJdFe8K+Dl0iFQ1opFEES2HgUz23Sk/T5mSoCDfAhc1fKXpo45ckf21241/U0KHOuZvwLbmEdCwTcjOJujsZaj5sHDkYkmbgf2Vso0TWcUaYZtfJK/M762KEgaF2Ar9Fci/QIDPIoawj/yW8C4bfdE/rrpViAANuhGJeFIUsiAZ/bMtuh8GKuULESpjkXApnjX75bm8HMFeHo7tnrXPvWsH5i7Qd6mUeMyhQBk0PgXEj5iCgu8nw48vtXGa7va7kZGrosg32qsELb3rljjdKOa0ShbkAyby3RDegAAAkJUYaJ1kMqFcGZz4l6+gqBzMviB9cp4EL2L7W2ZVK9uzTMNT23E4JPuEeBKJ/7XJGcKBdI15lgxkwNQXVk0V5FyGADt+URno//AdiVloKcqCZuI7cLgDzKX+wJF0eB5NRziubfkAyPw2jZQ78f74nviKqMY0X/G0oQ54vE7losrKc2YlWgPwRKk38tGge5Gytpwivpy8KsEURztt+uwFHQfL5UGAhtLkYRkOPjF8WQu2J9Ed6ESekBwG25PYH3jXQxXGTiirIJPJLeZL0s+xHeDw7eSTTR6dRAquaBnoXvS+Rmu1zsHRFf8Lt1WOAJvuZxAW7jHj23XcXqgDYBL0ig6oN84uKxLY0GYFe8QTk0A8wdLZxiwd0EYHbuRI4CABl8xUw8RNzHPK+pEum6u9zpLXyZOOSNFmzZpIFOWVB4rVB2fVMzQuWNuecl448Ws8AHfTBprBmbJFr5mhnrNXKkh0sAGaNit1RxUGUuJz8D1XdeuufRmUeikk+dNrrYMPHW95kpEGSUuzIDRKZTTTfWk6neUQuOnKvaI7PbJUMJv+pC2odET9mgAYF8twVjW6BXhcqc8AkYWwMr1JjA2GljYorQsAmS66/NRcCjb1bXQBgobCe/mU6CaPp4V+n/mksCMRYbwHVDm8H9gb2soW3V5up0J+tsqJPiiuFOOegxO9LKrTVV4wZemIMC+WLCQMEa8/CwiL6x/p0kVN9oPqpCsAu2IZHKGjoWtyi9cF7R8KbkP6YxrSQLEkgtxRRWCL0GxciMLPMMJLmMfn36tgo0NU4kmG111f+RrWC4DewDmSK7wtXOmFwISAnwvJNWUXzvZ5AYGrVxi/fMddBuAweiP/iNBoyLnFH9lnG4kSFZr6KMa0jQd5ipwHxaeNBoYKVEoUz+J5iIQO2wHsj1HWmmN0U+63ZapvoO6A4cheXK0kDZhaYOWnLIQ37bA8b2Zgu9TeIOG1NEA16y7EMPMpW2vfZJFm0vhw8EXoNcmWUR4waaFcGp8u+RxTMLJSnXSJF3Q+2VFZ3L0rKMWmWYIdYU0YlGElQyVk4nI+x301cP8VcgHzn3VeSyJ3UzBv8lHgIRqcRGSfRscVf+uCLipWBTs1EV2aAv/VWfa8TdTD6DiXAnFPGDc7QbuIhFVwuILji6sJH9wFMJRJkVTjoCCLZqMFNLhTgz5WWaBb1bb30pA/fu1EW6ejugl67c+PTNTF2XpbAfjOvZHCcgiVwM5/Nn5yCtwHV0nqJJzzQbRsQsdh1YSC4mZVzOM0wZ/JiLeVLerCO4cl7+9RG67kBLNbU4kXgcRjo201EUOJbS1//mSGLT2feTBQav4iHjps7T8e2o9KZqT8vGOH8X+QmOR41M+KGAWGDDfczXwSY9P/8JVXB8v076nQNv16v+UJaBdVxIwNi9S3ZmGZLz8sOhduT0SE65bq3ctMLWRIjKdCAsXTDhXFtcWnVGJrH9cJ+pMsDsn4WKHyiuN1lLu5XsEW4FyakDfMLIwStxXN1F0eicXsm1dSUhAmTfl+buUQJA44R8h6uR1qxoPMYPofErXipI86+3JoLSCk3lHUEm76XF0R1bQrNYsibimP2OO0Rcc7D8thA+l00/+GvvAqkTBDgdjP8f6og92SmJgSPi65QaY6jz3lJgcRWgC1GeduNFH4S74ChAaK5gzZoBOHhXCi/kune1x4hJU/1zshKPrbwza2UytdrSlmHmZ2N8GbYrHsSWtdijidI6TxsBoJ/SS7j/iK2fWTDc2IGmFAl9dbvZFctFmq61y/lMIACSARl5bAYwV7s1jQB1tUT76ANdugybIoNk+aZwwbool52w2c8j/ENrgxUpg/5AbUm2q/K6McPfB3/Ju/3coUhW9UivQTwUYE3T71BSy0XeIqTI25lFM9gQlnQv6maBjBAUPrQQwi1eaiGUqBm8/ypQAVWh5/RP4ilNxlPT6mo1ReZckxyO2ioFJtvcuCil62xyPzwR1cLP6Rg0eTIrMogpUIP73L+xzGdBj+sTuBxVs7ovK/vYLDFj2xBhO/uU71ygF4t7VIl+rcE6VOpWryeFAyYjLjo1v/Ct4Icg4eHKt9SKbmDO2xZP88/43smeZiIWqS8NIl5sK+mtwvs4wxOdPFNWq5gIy/OC/iXHhhmBmS5G8EpDQz65dIBnU5F4Yltf7jPpzSZr4Qf4FYyBYaSgcMm9gQFBPO3qahz3pqwad8yzNyDu6BS/2CKzhr8s2VfBxH6+EAIaywUgkoXma72vEWlSSiNEK23B2TCY/jWplRMhbABTfPA+lmbgSfuKy7dz09uEg58k6zzEfydUrQrfSsCZJhshJKlWJkNTed+5oPEXpEablv8Fe//G/tGspXgVLxV1o5vWo6VfrkK1l89yFdipBOx6i/h1ypuLpG1J/JGhKNJRGNmxvUSe4P2qZ1dpcSxCaH3MFq83cnYqdh87TACIrWvpgXrBFwU27oSCzGYAW9eYoR3ZYrcaw1VFtrm5Qd39sRdJ5O2Yr4oyLm2kGP5GGzCDQNtda26jBIyfFf3igHwWBUr2q32KmluWQQ1JmLpELO+RSNJWQCNRexDxbVynSokXdWUNhDd8tg6yGQFkmsxhkJqp5rt/+7aAKbQkxX/O1q/exy+ZSnJsZ9ZKc3xF3hGAMBX8SLIz62OmUrfSRkRYuJv0S65m+nkivOQPWoz7+Y3baHosrvBxlk9Z+iEfMR3cshj37XokAE/A05NSeJ6o2/qEeoxnVqDuPifd5aXdJg/BZeFtW2vYtG/c1w1HGyOcR1dlQSJau6uBlDs/yH6wXaX2x5YfctQCrR6snXkuWJyvJAAFaag8dOctpFrDXyAOr/qQ4Ir4mKS+bIuK85vrBYoaXZDuqnJSDnjXxQrgjXdHr3BiV/718rXF70qfC11c91HKL5kBicbf5D+rjbXrxu8dDwa06LpPbwYKRrY4z7KwUuIqtUFO9XIu1nwIp20jO8Rtdqn0o4snp2oUi/umFcOipJcqmo24KJ0dMYG3Z1JI8NxuXQnQpOurDQJ9rS06aO+R1mIzsa5vw7wxbzMRoLewNhX4I6KbNgEn3Aveemy1wDN3akayObSZE3NwhC9cUU4JGHWNjrJCuEeQz/aZ2yMn1UJrqU9+hbY60VLza9jdhZOK5W/xFG0p72N0gqvJJf/8lx1Wn8xndgJ0mJrTZLqyQyGslmQf+UCvgP7bptDoV+EkP48/4oQbicy0DihoYbvUY+CKVnnfFIvvI5mT2AUMHVLtRXXbl92qT1lQFnxhi8pB/rm0/O9e7TLQLsshEzLMkHSrPMKFfhkEwn61LiJvsS+ZpFWmQT0JALqlnK+ZP+1kvjoG55f8d4/R10/yHcBuOOxiNSouP5H1yJ+d9gHwy4S8H7ndUMp1NccPishAqFmOCtXsEmBqWTTJMHZUK8b8kgGWJLry54l/kkKZYDzIikXvKXnUD/xvWfkwdNs7g68ipsMejDpcFot9DQa58rb3wlwag9226cniZZNGjHjVO8aHfHrVdUmUD3PLLHDjYN1GA3Ms5SQY95y5yIkvgd018dSbKMcYi1s3Izg1wyGtVxvu6ODE5HDLOJjmi/nv7Fu/7vU3z51WKvN0925E2mFsfVK+qrZqUvVDyYTKx+vITUAXV0AXZA5hs4PxmaYo3gxQrBR3rk6jF/bZlwR34b9bjPPMyIZQc8Rj9w58GIvwYMV7D3mwIXjAFMLqygGENh/gAXGfXfdyYX5dEb24KbBfhapAURHhCXb/BYU3ZSzIRWL4AoyoHSfsY1skPkfUUTqVLkOgMoDKdrfsIuzlNpUrHJST6IIl2BBLijahTNNNp5GVRqqCiXxfBrMKbxwvWi156znMhcRBgokde+59F29D68LcvD+SMK2PZyjRVB8sphHSQGhob8hlFcRQeAqallEhUvxriKH8+jqx4QypF3wFHeJr12jpmk3EF88/UakrPAsf9LC454YfhsOSmLSES9sJd8ExIVo35csfpTvlfnPmi5wry71ew4Hei/zI4KLdjy0h/1xTJ0RB3PMBz/bTt6YkfDbdWwUReWDROLoIfmT/lTSfwozxRouAr3bZ/WmC5fXqxwaGN+2VwxKKUlWdQU3aD5F2gLmFTapAN+ePxCjcXtu/repUqHsBo1XjGjxZA3vWg5Vu66GT5omPabYLd+8J7+rIW+g1WmlD9Ud18ozrcuQrEKPTD5OGtpvXMzzIVqjntrRjlhHmIEKkedzSCgBJBOJQeH6N+cumjDe/d8hd0g+S2VB35El6INGpqngY/OA2QM7Z5MpqPl/hQ4jc0FGYTboycAlJwjv2+xZHLzpApMGQ2fHytEAuxTagaGAtDu2Z0cTzvOY4adWCFxpBXVFcX47wa+aKMtR/QBJ/wRyUCWAr3ZvomOf2nvCSN7lc/h6SfvD0iP4eJahO/rJ+p/XMERqBeD35jJEUilVXk4hXiIQpdHvIgx3RYMipXNeEDoEUIKW52MZnYqmpznBEmNSwx9Bdpn/m4jTuj/KGqd7WNI+tMfH/MeAgD+BYSAOcVkurvPA3AfkHQtWdJouMrU3eKXI+Xzz6wVnrAvKgbXG++XNyo1YqcAeykPYHpbxmSB3yKsILsqvSukz4MB1yIZoPhW+Chxft9kr7Xrx9l2xhxh4/D59w6uMDsz9E2Rjyc/PD/PxBGGALpsn4uyJ6ASTxiDqtq/Bjea0+lwlSjwtXlIdFrpccnlYudc3Lx8tiXUADCLiVw/bGj4qFBESbEcZ99xfgTuRlnajmGHBWgZjn/8FhIPPfz3UCn6kUIxOgJNvnp2aDZv5juNzXUeWTMyEuOOkBzfBl50PlzJEABNeslznidsomaKdvmGrtsMqgzhkv5+AHXrBhrCBWzOcduvTeLDxfvXgmRzgfYiQ7LwVtj/vjYRi5aJq2VHIpxSOjox1tkS/U0/6iT8uAz+NRwtT3cTfc8A07Pr54ZpbDZCfRz8TUfveZFRoMrRQnHgqx52GhPBiwBLQv2w2vaUNPGv7PqafrqRRMxHA8spPeO5yseig2/Dx8+0vHZdIJg/7TIE8IMu3r93dqG7CTDP4YbQ+Mn/RY/jIZZ5jWy9EeZn8Z72Xj5V/eaCU5mqNWa3a3TzgdI14XY/kk1EoSUCmWOXzB9CaDVzI6zTV1p3QMVirscPEapfBkL8Wu9Fy09uJ7ldin8G2lTi4Dva2BGFkRwTAg8AQ9OCXEwNo7oHZE7coceAoYAWxSg/0qmHOzt+DlroHwITsAYxnfwf1jZ5yksJI8vrABbb5WSu99YfE9FE8J+UXUQZAkODeJSkHZSS8JLEtsGFs8CA3AdsM12uY10IR2A7nCtXTHGIyXyObCoonCs0gnXHZHQTlIbwlJmk73YqbNOc8jcS/v5Uxe3whbAaThmKHX82adQMdbH5e710tBssVapsg2JX3RrRHuhJP9h4xXk7cMPqbDIU5w4AViMhckTwJ9wrnihnXn3Hju0Xafai4I+5FQSB8gxykUIPRkiHwAFWlf/uAz+0zmkdX1cONUvx4DAne9/zPRnF+MuJAXuYE9qEwpqaiEE2V/i+8e2Adjohw7ZfeXce6M9ENMO0S72v2TSrWMscyzPIl0FkXk7leDptyRmpyY2rJJXnhE4IwH3K5gKgam3074Ry+6LEmnpiQYQgZPCFXHFcAuKZJx/nc/+zIiiWhs5EgJCNWwvNxv1QKFsBx/mZj91alYkMNH2NLEnl6o4J3vPqAvCekaOXQXvQmJWxGGoTWgICSuXf5AZw33YBkkgG1hPFZVxOLGaGiW/Nb+dMAbKuSk6xTYEUls0ckJBtLALNLtqWDNGI5hJoaWaeIGY7bSZtX6E0QldPHNwPMcsmCwyAM+juHQyKd+laqq4JIg0pbdGkhdYTCL7kmgCwTbdl9l0ksgK6QTAPCXlv7/g9Ixnv3wvKzQJPoDuHG4WM6unK22PSq8IHnpgtmafZHmNtQljIRgii7LHlgJ5cRKL3KJCH3kS/T+Ne2HZe9xb4sDLSpe8BG0JRFQMP0d04B9Q5kA8WA7aMAY54vRxW6UBFiopyybPpxvX4vfGwZHdLe7vPFUUQQLOXD6qNKUlV6JzIMS5k8BDyi45+EWdOkDNChs5XRNjHrRLEEuJ9LPB4NHxLdvnF2IwRO2cCkysqVKe58EG4mT/aoQISi4O2+ukLV1jUxuu+FVb0nblY3EdSS/OaHKM0wEmvrGqYpBdrT/b4Db5MR56Wo+oCkGMYpwt+paVYwv5rei4ANHqK9IfWnvf+eHQ1IhAEk/Na260JeYyJO3OxX4d+ESAS1QEOyMj58OVIFtMDLXJZOFVJd4aQ5Qi4uAIUG5YJZG+6eQz0hBFSqwgx74acLOGXk/I0k7cD5C5kkpcWM5PZhB1b4WaYraDKUUxhwXXdPUZ657GxaCYsD+fQ+7EH/tUs2YCpNLCU4YsjTJrQ7sVjOUe4hdv+aN848coaOvN8gXxviMzIEND+/gu9GGXJApWoHj9edKQ/83zSzEECPKlCEVl7fEMOjGIV6lhlsz+NMWd4yCXR+EGBVeoTNbsmaXnbaLuQrZqdA/7dmaxr/rrni1Il0crdJF8FiODWQmNzbJlnmzTgqFXOrDZCA/WzjBV7Yk5kxmCOuouLABzh6EnzS447uHQo1rocfqXVH9GvnyW4HWC7d7QlMVhpDyl6d+amEiRK6FDnwDha9eHnosP4ZEcKZu5wiCFVAcEVYTPZLx3BboW1OJmBHKGmZqlnpVal3BNQ9NhnJtJbJdTV2Bw3HCZxDheNOEx0SmznnaF8gcxFBwgvU2/X3aD1va4UMOLtYgQKazb7ry/MhHrjELB7QVyA+dyYZo2iuBuGtX+Agu2qGXgBlbJ+Aqfb01LZLQFoJvTAkoOVQdqEJDLoRPF1k25gshTu/pnkEkQ8pGgKV041SMpcUuxceMXwWVwXJGEsBSZmDM2bMCfjxmlCE0FTkme7sJhhxwS0gI+5VnlNtMgEnqS113fzsfRuwc1jBPAS0Jc/Oi8xi18rcBwIrjJkJByKf+9XDBy1sxWadMUDTfzeRbUoFeC4/pQtaEGqa3cLH3cuGiA9otMHi92l4Q2d1HIb6BVpUz7E2PfcK7t5DuMMOwrXxM2CXsMxC0Ny90fPQdalfeLHmMtIJYCL6RjnwunDpp6lJPW4AVjdInfml9r8u317SUOYv0IvvBaZ4muKSL/v3it91zvZ3LQTTe51P0L8fEZXT0sM/PcwTuA5QUWBuU4bjYgEpWoeakNEpp/8rvdg+DvzcYvf1MKUCWjKcjNzSp5mTu03t5eLBTK5Io0K2/vwkU0BFteNRnJo5YlTzvtbgryDJv/QNWAFcj/Z7kj1mTZ5wwzlYPcmIoBwOBkkd/juELMDpIs6jR+l3Dt5DK5+CP0WDexmNRSrBzaL21PgfVZn4Xl+ItjQvfKLIcxvTm8aGmkc008Xbt/H9iV4RBgc1wVJBFzXl/c4Gr+I5BzuQWkvabL9C+WsC0R5iJDY+t4PCqwjb0oLDco0XcqFVqG8/qB5Dw+LrFBBO09UVQE8Cpou02SiENdW5HbiNc9t7hMPsfGzG6syQIhc+Zo/OGzWUw/MoMkDv+njMLl7WHNvDUAb3G/Rpq5bJlEUwy/afCXNXa+hdmaVuqVcfOE3j0g5f6yK6YzLLx/H6og9Vq15hwSbw179x6d0u7ZP9npnYNzFFk8sWLY1HBLSlAjzuaqQdPJ2gClsjDCmBvQPR4zCZrjec8ymMuZKIAGGM7S+6QPmC5fiJFMQfkGliehOB+cjSLjUAInJLoixgN8mQ/QAZvfB+9f3I9LfKqpHIAtqEXqflJI9euRkLz2xrTshVgzZb3qXQXHkcR9u379VPJiZacyFd9FxoAaD+TYYK6f/t8zBURIjYD3fTJPS9t3rmLzikokr3ra8dxns3H5Xsj8/P018MwI35r7G4LBnkAhvt0CiDet/c8e202v0nQ97aee7hluUYb3zmK+nZBZ/7kACz2ChH4SWyKCbDLIrYIE2LnI1Z/rFU4bzvGXaDHYtPZ/OdA6DbiY+0StRsGymlhoDq5aW3rFgEKEml+LghZVuJwFXW5NQH90piX/9PsB6CI6rm378YuN+IMC4QGZ8cT90svMS80tSG2IwCClh7JYNiuUS8mTsgn4oqOuFO7m7eukIAyTRyxPNaVByxf3pi6KHNuysJKOXprSGoPEGlJCJS/2WSuK4mtJgKR/kEODrYDlVT0tM6ZT8PNmn+ucT1esv0tVKO0qDxr0YoudAYcZHEbmNSOyNDoaWtsSCGPNXyakrcd3z9Ab8xGUD/LfwJUWeu223/Kqgj3A+xytmnvOeCDgaOBbPhC+u0efslsXM1cHMtYu+eh6ZepR0vxUYVpGDXgwXSVIv4G0L4VLfInGmAvkbYgiWoxgz0PDLlpFec1XzH0xTugKSggh4kKJcKNTYY1SJjmaKBKNrs7DImQbB9nx+mV01sgH6raO/h2Pn83CbgpzwGZ8nJY13JWR0lHC/aDSB/6/DGKSCaFieCViJctoUknWtGfJELlhJ/R5BdSXSTGHu+Os/JEd5DhF8Oicoo3ZtnU4u+6QPikpRXldO6ct81z2iTpuTRUcwTAeI9hyWtidTdJQhg1qNaZudJXy9hfocKv5p3p1lPCUJzZASqX7LrL7AW3u1WNwF+CIoSsy0wOLSnVpbn6XvCja7VxrRh4DZIr7tUZs1ygDNo7zGJbFlQTaEIONezBzRm0wMjzLjuc+3ULMHD2R3p0EEZoJrZkwELP0EDAcl9tVvyzqSIu+0d5HCnGrkAL6AS6nMM86bOuByYcHqgd03ED1qp4EgJLzi+rgKJrKsvctWcUhexBbB4FupEDrdr6xGtxc9LBG+S7heAekd/ONYOatbTQ05ThEwpHwNV8KN1bsD83Y9WmW+euAZoQLIDH+qp2HciGr1CfDeabGHIBiHdKeY4Iai1mLWURTkO1cBvupvVm0KBXfbH1z6Od8Ev6mic1tf7DPqnfbFE4a6NQxSh5aDmt47YCeGaHQA5Oq/K7Z0CSdaqU+MYpeQLedi4kAeey6rVEbJhj4C8jTfY+mLrSJ4iiKHfDyvlUBBoXPHyHt44ut3CkZArhWtkVt693L91aDQceTlGG5kcplufYSy3L+elcQYSf+LBz+aNIJkmeDoliVvd4PBT1Ss4HbtBXnQWOxKVqc6XSSJFDOmEugcBmEOXMD1npfaT6d6BpHPQcHHSXZVvJeVO05mxqb1wgTpYhcWylbOt3Q8wI8RpHHaVVpWM/UltQ1GTKpEr4XYw0iKXJq0Gu/LYyRCxwze3tYEa3jCOYJ8x/g0/pR+t34GAKNbJPHw7x00kKlP34UX1aeWo2wUoOrRg62c7A31gCeHTQzuMOdptNKsnvAZ5H8aS9HYFrPiPlM6Qe8Iv8wb3HIoyEdDdssMJUGwhfc9jSko7k2TSwnQ5EGZLQDPezl/5COWdlkWyhgqVw3bXixQdJR82H30n2ElepYadCtECHRhMtoC6LX5S6mPjlz9pnW3JNkD9aO5E9WJ8TVq82taRtL+xC9Swywelmihq/8e6zP0cwMH6v4hmnEMomev3lqcEWLjXA+s4x0Nys8pZeazE/IOCqqo4ZNZ3wxpbsVdEIZtMkefnrFHx0do17jnZjMzqB0dCasgiDvvNQyNjHLSklMeF1awUsnTpixJEWH3OvJrSjCh6iW35z1DogHlkZeCfafbQ8FwWqsC+esKEJ+tkBYB/315ylSomIaxnRhPwd4nSfvBcF+bvRFEnJ78jWB1lVdOAckx25oOh3+IngIyYhiU53/hd8+tw1d+XZkYrUmeojurKFerhgVTzP5IVIjcGW2t/rJ8Hw0UIY4pFpxf+WWbH79I2L+54xZuoOeiJmF2ks9XfGdpfDkEVmrkK/orGKJtYM7InTqYD8iMrkK3lhHNoyywV8+xj5ZXAJaj06tAiYscdEnHBOgEZWrhk9np7/J0ezqDjpKvGA1TtYE19PJ5dZvpk4XEdM2h3tbWDY8b+YJOuEMCXiqSbKIpDPQzWDVC8/vOSUCTgI0Ayy7XO8x7F65VCIYcJAW1VL+e/aFjhCrNBV6YM+benD95jEcISOzKwrg2toQW0MBbQZPPj+Ob6d7r6ASuWXH5IUcx5k7qmanFx0RLlA+Kkhlo+gri9/FS/8IleH0Zwbzd5cMbbHYrm1ClVtrh8zVtyL0CCvoXaexvi9uTfn82NT5lIlHq+H+nuAs7d0y7KV4wuKKEQRmCSRSv7w8Jv79gpIPWPfWXTy/FMt/2gZ6F/dm54HgeMTREzZg1W1DRq2GwDyDt0yejdZkrjc8OpOqpa10tvyTOsnfqFl+IRyYeLWMl0WdPe6IgkLJT1ZMgPdVLy3pWPV2/Boan/PtBGTwmoFLVhGDkA3chADAd4NQYttiK8LAKp1LKh0ucU2uKpj9BQKASRtckPXsa4BWd3VoqvmtFJm4trS+FdFKAyPj0dLZxd1hMgXrWQKD1xs0k/OMfL3VqOwfRj+guaRLgl+VnLm26QvFtY1ASiyd5+3IKmpsMKcuqoqbQxUIKipqfof6pFdG8Be7LOF2V9sEy2eUiwFkJIutyfy5sblIN76zkVfZFdsHbGMom2llDxTWdRVMhHoWn79PwBqdurahyONLbFZv33SXCQLsdIQ+PfJr11ARGSqgN8oveyIRR6vTT2s4c7ZbQrec5uuvtC050cBgLv31nLbBuTqsnSA6N124NoI7SBZB9d13TE9Ml2RhXaaYkFuDx0oo6YwjS7KG3jKE7eLyUBkDCh8dqUtPHjjkxsw1DTLhtJ48C7BGuVN0R26QHzsbTscd7UuR0XpjYcpzi3vcG4/97eJ4m2KgUeQQpeiEswfklZ+aSfVX8ySOozWSQCdDXl4rJuMX5ImSF0cxuFF7kqaFQXjMZ8rDpE7/J3mNkO2O5bBoqM4zOcGQrUBfu8FMfHRyhDwkPw2gEbG/0FgYG3mPETsxoNoNZy68q7aTRBwJhZ9iLQCYdRnua1F1oytNVX+3tIDugYDNZKXu2rvwTVwN38aLh9YnhiupGaqVhPHiJAptbCcNWQLiZOxWJubtrxrzz0up6d3n8aM+sX9DWmZRFZFS0ISQ0Jq51bf30F/8xkIppcmdX8Svgh0NZo9G3SXJocdGhAuKv9DvwvlMLy5+CgzylC2U7uH5a7Il5D/Wl57IXPFt89FH/Ma0N9XcaHr+nLf/QGMjeHGaRWVIWsOywDVujeY1s3WsC6A7yuDOBgwjF2kGmnVIZFvT/Z5aLIXMPGLMIohK9oSTHeuBHspyc0TIfmMCGaw2VHPI8/ZL7DmQZwu61XOkBu8tExo8Eu2d8C1s3HXCCpQ/BJ+D+mNiX/iw5M1r2OM/uw7oVTba6EQIEiv4htOjDSDo91kcs2SDoMCL4FrJ1BZmQJC6GUz9ZDBMlpfaf1rf3xFbgEK8MkBxKvxitzkxXz/o2WilVHmQl60Givlpmpf5FNcMYRYUWvV/eLagExu9phPMDVd/n3FxZLqNq2AgxA/C7J8aXoQ6cnFL1vw7hLXO2ASUFLaaaFYVooQvRunBKZ07BD9ta5aFRKSj/WAhPPmXg9Fo21oOdg6OST/2L/Lf/1oEC+urXZa5wjDLpVrG1hGicIL2BzbIwCKvywcKVLy/qhJ8CcecBo8Yfbs7SsoUuughfNprmMCH7I2MnzH9B3d85SpTEdLhcrEQyj/I8bgYjPiwX7yDVctbq/jMgoNVsUIk2R8DOm6lxCdhlCd4+0TjglDFgOfqgCtqBbLIy2u8mt0FNGI0kHc3n0MOzrE9fW1y87mqL5hYFFvMf615lDIysrPqYoIjseYO+YvfN+gTqjWcetRQR19pVvszKy/Yny4NzWB8PVefPorxLfcWmqhFPFoIEwvDvRTjaAIQfN2v3zVfJiv7Mg+vPjzQJjbdwF2gpiqSnwJ/fTMIRwmN39ZZnpa+DTE1Ke2qAqO8Gtb6KvtnsTjrZUm+Hj+UMyVxXENrCP8niUycM50mF8ZYYVkw2NZckSX1grYRYM7gXXzoXKVRGRzLgYsl3MuJ04nPvXu0fcDjm3U1ihYJLzZmXXUL/bVN64X3t/ogBbKgQlwd6OCh3Y6AUNvw0cb+sJIVzCADQHQfoD3W/XJZq19HaR39/550kEinoUheOXPLuJ26sCNNBLfXXH04O6Npaek8g4wEUcDc+AowpDUCY1fNLEBLh/jw4NFE4a4vV0FxGvQWI3XyhUnNVKmWbDFO+55RScmgSui9R+fim926kd4h5oGFK1IfiKNHvMEaelw7EJokbNs5ue4kqKpeOHatR8hhC9EP65wwMiPA5uN6NVul/vYyOTLhDp5tkRhxYstnIzqTY76HN4ycVPu84YGulfdW0hLVCDnMBr2YhXS18tWESkUO+PZRWyNG3QrkFH8Ae8q6B1uIpRj+JXSXxuORYi1j2/gnYlroXJ4dulaYQ2MBZAaZ6nIOy71xFQ7Q7BD+ILX4txwpFCUGputybgbRoy/m/eB+f7PxSP6ordNrb3wpH/0DanKa1PAXJNZTfdc8s+KOssj4oiYC1yVO6DvOVIIx1Y7JVqMeLit/wi/n/psAXTVyCyF5gy3duhcGQaSIOZl1vgHzEuF3PYgGxVN57JBaVTEzeuB0Yi1K95pyj8oJ75ZWps9WfYk/i1nmAvuxUVlHcWGV60O/tdUTRtO83bV11emoNGmHiutNbXh/Dnhh2HWgbkCBiZuC35bA5kO/oxGSa4BQ/JHRZzmtfbQgcaS5aYXhqQM4lm+gzc1zRBuzLBXzLJhWunWXpGoJ1EfVfoNtjINnXUm8UawN8ZTKgVfDaYGQwLIcor+/WRo+1osklLtrijp77tRbUNVYJ0ikk+5Dz5WX5ixHo7/1bHd4j91EgQSB7MAk7ONjo8ZbVEfDZR7+/tvTKOqdFgzdlfskiCKt20FfrhZyqTWMZFnyxn0/0GZnPdGzzPDenv99FekGqd5x2iVm+aHHbtvVif1HeVCzCGctVjahM0ThfF3ZdKrKCV1KukQivNCR2tJL6gztL6sgO3Y0rs0HKAXuZRtDC2nQnwuF28idAYGigrNrCsqdXTziEdAm/faOxuXZGZTdef6iOQYtB9I7Kj1nbo0+grdAp2ZvLw4zmX7uHIB2bttuEX+Rakjm7SXMNCw+q5EpcUcHtQipHBMz+PZFM7rtUuL+OwdmlnEoDF0QgyJEHwL2BAKEsgPh4WRM8TFWvO40IAxL+lq6aM4EWYB5GIxmis8cMZy5MdQPlAKMGLFGRL5KmTj+ua2wpVPTSaLIApzwfyGFCARThCVIDPPqGf7kC4o3vfmN7XIUDjDKos3LJQamU68dFhkvnzxB0jHouDRnyQ8d4ROqCCDizsgy8dB72wWsRUC27NfTc3wRU8Ds13HKRr15TdPZ/8UDLJK1vDiiJI`
 	raw, _ := base64.StdEncoding.DecodeString(s)
 	var key = []byte("LJg0DdPiCGYyq9h4")
-	fmt.Println(string(AesCbcDecrypt(raw, key)))
+	b, err := AesCbcDecrypt(raw, key)
+	fmt.Println(string(b), err)
+
+	if _, err = AesCbcEncrypt(raw, key[:5]); err == nil {
+		t.Error("invalid key accepted")
+	}
+	if _, err = AesCbcDecrypt(raw[:5], key); err == nil {
+		t.Error("invalid length accepted")
+	}
 }
@@ -0,0 +1,122 @@
+package lib
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Clock 时间来源，补数据时固定为某一天，测试时可以手动推进
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// RealClock 系统时间
+var RealClock Clock = realClock{}
+
+// FixedClock 固定的时间，等待仍然按真实时间
+type FixedClock time.Time
+
+func (c FixedClock) Now() time.Time                       { return time.Time(c) }
+func (FixedClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// FakeClock 手动推进的时间，After 在推进到对应时间后触发
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	at time.Time
+	c  chan time.Time
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{at: c.now.Add(d), c: ch})
+	return ch
+}
+
+// Advance 推进时间，触发到期的After
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set 设置为指定时间，不允许倒退
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if t.Before(c.now) {
+		return
+	}
+	c.now = t
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at.After(t) {
+			waiters = append(waiters, w)
+		} else {
+			w.c <- t
+		}
+	}
+	c.waiters = waiters
+}
+
+// Waiters 还没有触发的After数量，测试时用来确认协程已经在等待
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+type clockHolder struct{ Clock }
+
+var clock atomic.Value
+
+func init() {
+	clock.Store(clockHolder{RealClock})
+}
+
+// SetClock 替换全局时间来源，返回恢复的函数
+func SetClock(c Clock) (restore func()) {
+	old := clock.Swap(clockHolder{c}).(clockHolder)
+	return func() { clock.Store(old) }
+}
+
+// GetClock 当前的时间来源
+func GetClock() Clock {
+	return clock.Load().(clockHolder).Clock
+}
+
+// Now 业务时间（今天、按天计算等），补数据和测试时可以替换；
+// 队列、锁、缓存刷新等基础设施的计时仍然使用time.Now，不受补数据影响
+func Now() time.Time {
+	return GetClock().Now()
+}
+
+// After 代替time.After，使用FakeClock时由Advance触发
+func After(d time.Duration) <-chan time.Time {
+	return GetClock().After(d)
+}
@@ -0,0 +1,40 @@
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 10, 1, 12, 0, 0, 0, time.Local)
+	c := NewFakeClock(start)
+	restore := SetClock(c)
+	defer restore()
+
+	after := After(time.Hour)
+	c.Advance(30 * time.Minute)
+	select {
+	case <-after:
+		t.Fatal("fired too early")
+	default:
+	}
+
+	today := DaysAfter(0)
+	c.Advance(24 * time.Hour)
+	select {
+	case <-after:
+	default:
+		t.Fatal("not fired")
+	}
+	if DaysAfter(0)-today != 86400 {
+		t.Errorf("days after = %v, want %v", DaysAfter(0), today+86400)
+	}
+	if c.Waiters() != 0 {
+		t.Errorf("waiters = %v", c.Waiters())
+	}
+
+	restore()
+	if time.Since(Now()) > time.Second {
+		t.Error("clock not restored")
+	}
+}
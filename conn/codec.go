@@ -0,0 +1,141 @@
+package conn
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/klauspost/compress/zstd"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// 序列化格式
+const (
+	FormatJSON byte = iota + 1
+	FormatMsgpack
+	FormatGob
+)
+
+// 压缩方式
+const (
+	CompressNone byte = iota
+	CompressGzip
+	CompressZstd
+)
+
+// 头字节最高位固定为1，json不可能以这种字节开头，用来兼容没有头字节的旧数据
+const codecHeaderFlag = 0x80
+
+// Codec 缓存的序列化方式；零值为不带头字节的json，和旧版本写入的数据完全一致
+// 其他编码会写入一个头字节 1 | 压缩(3bit) | 格式(4bit)，读取时按头字节解码，所以切换编码不需要清空缓存
+type Codec struct {
+	Format   byte
+	Compress byte
+}
+
+var (
+	CodecJSON        = Codec{}
+	CodecMsgpack     = Codec{Format: FormatMsgpack}
+	CodecGob         = Codec{Format: FormatGob} // 注意interface字段需要gob.Register
+	CodecGzipJSON    = Codec{Format: FormatJSON, Compress: CompressGzip}
+	CodecZstdJSON    = Codec{Format: FormatJSON, Compress: CompressZstd}
+	CodecZstdMsgpack = Codec{Format: FormatMsgpack, Compress: CompressZstd}
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+func (c Codec) Marshal(v any) ([]byte, error) {
+	if c == CodecJSON {
+		return jsoniter.Marshal(v)
+	}
+
+	var b []byte
+	var err error
+	switch c.Format {
+	case FormatJSON:
+		b, err = jsoniter.Marshal(v)
+	case FormatMsgpack:
+		b, err = msgpack.Marshal(v)
+	case FormatGob:
+		buf := new(bytes.Buffer)
+		err = gob.NewEncoder(buf).Encode(v)
+		b = buf.Bytes()
+	default:
+		return nil, fmt.Errorf("codec: unknown format %v", c.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.Compress {
+	case CompressNone:
+	case CompressGzip:
+		buf := new(bytes.Buffer)
+		w := gzip.NewWriter(buf)
+		if _, err = w.Write(b); err != nil {
+			return nil, err
+		}
+		if err = w.Close(); err != nil {
+			return nil, err
+		}
+		b = buf.Bytes()
+	case CompressZstd:
+		b = zstdEncoder.EncodeAll(b, nil)
+	default:
+		return nil, fmt.Errorf("codec: unknown compress %v", c.Compress)
+	}
+	return append([]byte{codecHeaderFlag | c.Compress<<4 | c.Format}, b...), nil
+}
+
+// Unmarshal 根据头字节解码，和Codec的配置无关
+func (c Codec) Unmarshal(b []byte, v any) error {
+	return Unmarshal(b, v)
+}
+
+// Unmarshal 解码任意Codec写入的数据
+func Unmarshal(b []byte, v any) error {
+	if len(b) == 0 {
+		return errors.New("codec: empty data")
+	}
+	if b[0]&codecHeaderFlag == 0 { // 没有头字节的json
+		return jsoniter.Unmarshal(b, v)
+	}
+
+	format, compress, b := b[0]&0x0f, (b[0]&0x7f)>>4, b[1:]
+	var err error
+	switch compress {
+	case CompressNone:
+	case CompressGzip:
+		var r *gzip.Reader
+		if r, err = gzip.NewReader(bytes.NewReader(b)); err != nil {
+			return err
+		}
+		b, err = io.ReadAll(r)
+		_ = r.Close()
+	case CompressZstd:
+		b, err = zstdDecoder.DecodeAll(b, nil)
+	default:
+		return fmt.Errorf("codec: unknown compress %v", compress)
+	}
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case FormatJSON:
+		return jsoniter.Unmarshal(b, v)
+	case FormatMsgpack:
+		return msgpack.Unmarshal(b, v)
+	case FormatGob:
+		return gob.NewDecoder(bytes.NewReader(b)).Decode(v)
+	default:
+		return fmt.Errorf("codec: unknown format %v", format)
+	}
+}
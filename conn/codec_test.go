@@ -0,0 +1,37 @@
+package conn
+
+import (
+	"testing"
+	"time"
+)
+
+type codecItem struct {
+	ID      int64
+	Name    string
+	Created time.Time
+	Tags    []string
+}
+
+func TestCodec(t *testing.T) {
+	item := codecItem{ID: 1<<53 + 1, Name: "测试", Created: time.Unix(1700000000, 123).UTC(), Tags: []string{"a", "b"}}
+	for _, codec := range []Codec{CodecJSON, CodecMsgpack, CodecGob, CodecGzipJSON, CodecZstdJSON, CodecZstdMsgpack} {
+		b, err := codec.Marshal(item)
+		if err != nil {
+			t.Fatalf("%+v marshal: %v", codec, err)
+		}
+		var got codecItem
+		if err = Unmarshal(b, &got); err != nil {
+			t.Fatalf("%+v unmarshal: %v", codec, err)
+		}
+		if got.ID != item.ID || got.Name != item.Name || !got.Created.Equal(item.Created) || len(got.Tags) != 2 {
+			t.Errorf("%+v got %+v", codec, got)
+		}
+	}
+}
+
+func TestUnmarshal_legacy(t *testing.T) {
+	var got []int
+	if err := Unmarshal([]byte(`[1,2,3]`), &got); err != nil || len(got) != 3 {
+		t.Errorf("legacy json = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,23 @@
+package conn
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterMetrics 注册conn包的prometheus指标，不调用则不暴露；reg为nil时使用prometheus.DefaultRegisterer，重复注册会忽略
+func RegisterMetrics(reg prometheus.Registerer) error {
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+	for _, c := range []prometheus.Collector{queryDuration, cacheRequests} {
+		if err := reg.Register(c); err != nil {
+			var are prometheus.AlreadyRegisteredError
+			if !errors.As(err, &are) {
+				return err
+			}
+		}
+	}
+	return nil
+}
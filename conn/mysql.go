@@ -1,48 +1,369 @@
 package conn
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 )
 
-var mysqlClient *sqlx.DB
+const DefaultDB = "default"
 
-func ShengcaiMysql(user, pass, host, dbName string) {
+// MysqlConfig 数据库连接配置，零值字段使用驱动或database/sql的默认值
+type MysqlConfig struct {
+	User     string
+	Pass     string
+	Host     string
+	Port     string // 默认3306
+	DBName   string
+	Replicas []string // 只读实例host，配置后select会轮询走从库
+	// 连接池
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// dsn参数
+	Timeout      time.Duration // 建立连接超时
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	ParseTime    bool
+	Loc          *time.Location
+	Params       map[string]string // 其他系统变量，例如 time_zone
+	// 启动时连通性测试
+	PingRetry    int           // 失败重试次数，默认不重试
+	PingInterval time.Duration // 重试间隔，默认1秒
+}
+
+func (cfg MysqlConfig) dsn(host string) string {
+	port := cfg.Port
+	if len(port) == 0 {
+		port = "3306"
+	}
+	c := mysql.NewConfig()
+	c.User = cfg.User
+	c.Passwd = cfg.Pass
+	c.Net = "tcp"
+	c.Addr = host + ":" + port
+	c.DBName = cfg.DBName
+	c.Collation = "utf8mb4_unicode_ci"
+	c.AllowNativePasswords = true
+	c.Timeout = cfg.Timeout
+	c.ReadTimeout = cfg.ReadTimeout
+	c.WriteTimeout = cfg.WriteTimeout
+	c.ParseTime = cfg.ParseTime
+	if cfg.Loc != nil {
+		c.Loc = cfg.Loc
+	}
+	c.Params = cfg.Params
+	return c.FormatDSN()
+}
+
+func (cfg MysqlConfig) open(host string) (*sqlx.DB, error) {
+	db, err := sqlx.Open("mysql", cfg.dsn(host))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+
+	interval := cfg.PingInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 3 * time.Second
+	}
+	for i := 0; ; i++ {
+		ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+		err = db.PingContext(ctx)
+		cancel()
+		if err == nil || i >= cfg.PingRetry {
+			break
+		}
+		time.Sleep(interval)
+	}
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("ping mysql %v: %w", host, err)
+	}
+	return db, nil
+}
+
+// MysqlDB 主库连接，可以直接当作sqlx.DB使用；查询类方法会将select分发到从库
+type MysqlDB struct {
+	*sqlx.DB
+	Name     string
+	replicas []*sqlx.DB
+	cursor   uint32
+}
+
+// Replica 轮询获取一个从库，没有从库时返回主库
+func (db *MysqlDB) Replica() *sqlx.DB {
+	if len(db.replicas) == 0 {
+		return db.DB
+	}
+	n := atomic.AddUint32(&db.cursor, 1)
+	return db.replicas[int(n)%len(db.replicas)]
+}
+
+// 只有select语句会走从库，select ... for update 等加锁读仍然走主库
+func (db *MysqlDB) reader(query string) *sqlx.DB {
+	q := strings.TrimSpace(query)
+	if len(q) < 6 || !strings.EqualFold(q[:6], "select") || isLockingRead(q) {
+		return db.DB
+	}
+	return db.Replica()
+}
+
+// 按单词匹配 for update、for share、lock in share mode，后面可以跟 nowait、skip locked、of t 或者分号
+func isLockingRead(query string) bool {
+	tokens := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ';' || r == '(' || r == ')' || r == ','
+	})
+	for i := 0; i+1 < len(tokens); i++ {
+		switch {
+		case tokens[i] == "for" && (tokens[i+1] == "update" || tokens[i+1] == "share"):
+			return true
+		case tokens[i] == "lock" && i+3 < len(tokens) && tokens[i+1] == "in" && tokens[i+2] == "share" && tokens[i+3] == "mode":
+			return true
+		}
+	}
+	return false
+}
+
+// sqlx.DB和sqlx.Tx共同的方法
+type mysqlConn interface {
+	sqlx.ExtContext
+	sqlx.PreparerContext
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// 事务中的语句都走事务连接，否则按语句类型选择主从
+func (db *MysqlDB) queryer(ctx context.Context, query string) mysqlConn {
+	if tx := TxFromContext(ctx); tx != nil && tx.db == db {
+		return tx.Tx
+	}
+	return db.reader(query)
+}
+
+func (db *MysqlDB) execer(ctx context.Context) mysqlConn {
+	if tx := TxFromContext(ctx); tx != nil && tx.db == db {
+		return tx.Tx
+	}
+	return db.DB
+}
+
+func (db *MysqlDB) Get(dest interface{}, query string, args ...interface{}) error {
+	return db.GetContext(context.Background(), dest, query, args...)
+}
+
+func (db *MysqlDB) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) (err error) {
+	defer func(start time.Time) {
+		var rows int64
+		if err == nil {
+			rows = 1
+		}
+		db.trace(query, start, rows, err)
+	}(time.Now())
+	return sqlx.GetContext(ctx, db.queryer(ctx, query), dest, query, args...)
+}
+
+func (db *MysqlDB) Select(dest interface{}, query string, args ...interface{}) error {
+	return db.SelectContext(context.Background(), dest, query, args...)
+}
+
+func (db *MysqlDB) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) (err error) {
+	defer func(start time.Time) {
+		db.trace(query, start, destRows(dest), err)
+	}(time.Now())
+	return sqlx.SelectContext(ctx, db.queryer(ctx, query), dest, query, args...)
+}
+
+func (db *MysqlDB) Queryx(query string, args ...interface{}) (*sqlx.Rows, error) {
+	return db.QueryxContext(context.Background(), query, args...)
+}
+
+func (db *MysqlDB) QueryxContext(ctx context.Context, query string, args ...interface{}) (rows *sqlx.Rows, err error) {
+	defer func(start time.Time) {
+		db.trace(query, start, -1, err)
+	}(time.Now())
+	return db.queryer(ctx, query).QueryxContext(ctx, query, args...)
+}
+
+func (db *MysqlDB) QueryRowx(query string, args ...interface{}) *sqlx.Row {
+	return db.QueryRowxContext(context.Background(), query, args...)
+}
+
+func (db *MysqlDB) QueryRowxContext(ctx context.Context, query string, args ...interface{}) (row *sqlx.Row) {
+	defer func(start time.Time) {
+		db.trace(query, start, -1, row.Err())
+	}(time.Now())
+	return db.queryer(ctx, query).QueryRowxContext(ctx, query, args...)
+}
+
+func (db *MysqlDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return db.QueryContext(context.Background(), query, args...)
+}
+
+func (db *MysqlDB) QueryContext(ctx context.Context, query string, args ...interface{}) (rows *sql.Rows, err error) {
+	defer func(start time.Time) {
+		db.trace(query, start, -1, err)
+	}(time.Now())
+	return db.queryer(ctx, query).QueryContext(ctx, query, args...)
+}
+
+func (db *MysqlDB) QueryRow(query string, args ...interface{}) *sql.Row {
+	return db.QueryRowContext(context.Background(), query, args...)
+}
+
+func (db *MysqlDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) (row *sql.Row) {
+	defer func(start time.Time) {
+		db.trace(query, start, -1, row.Err())
+	}(time.Now())
+	return db.queryer(ctx, query).QueryRowContext(ctx, query, args...)
+}
+
+func (db *MysqlDB) NamedQuery(query string, arg interface{}) (*sqlx.Rows, error) {
+	return db.NamedQueryContext(context.Background(), query, arg)
+}
+
+func (db *MysqlDB) NamedQueryContext(ctx context.Context, query string, arg interface{}) (rows *sqlx.Rows, err error) {
+	defer func(start time.Time) {
+		db.trace(query, start, -1, err)
+	}(time.Now())
+	return sqlx.NamedQueryContext(ctx, db.queryer(ctx, query), query, arg)
+}
+
+// Preparex 按语句类型选择主从或者当前事务，只记录prepare本身的耗时
+func (db *MysqlDB) Preparex(query string) (*sqlx.Stmt, error) {
+	return db.PreparexContext(context.Background(), query)
+}
+
+func (db *MysqlDB) PreparexContext(ctx context.Context, query string) (stmt *sqlx.Stmt, err error) {
+	defer func(start time.Time) {
+		db.trace(query, start, -1, err)
+	}(time.Now())
+	return sqlx.PreparexContext(ctx, db.queryer(ctx, query), query)
+}
+
+func (db *MysqlDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return db.ExecContext(context.Background(), query, args...)
+}
+
+func (db *MysqlDB) ExecContext(ctx context.Context, query string, args ...interface{}) (res sql.Result, err error) {
+	defer func(start time.Time) {
+		db.trace(query, start, affectedRows(res), err)
+	}(time.Now())
+	return db.execer(ctx).ExecContext(ctx, query, args...)
+}
+
+func (db *MysqlDB) MustExec(query string, args ...interface{}) sql.Result {
+	return db.MustExecContext(context.Background(), query, args...)
+}
+
+func (db *MysqlDB) MustExecContext(ctx context.Context, query string, args ...interface{}) sql.Result {
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func (db *MysqlDB) NamedExec(query string, arg interface{}) (sql.Result, error) {
+	return db.NamedExecContext(context.Background(), query, arg)
+}
+
+func (db *MysqlDB) NamedExecContext(ctx context.Context, query string, arg interface{}) (res sql.Result, err error) {
+	defer func(start time.Time) {
+		db.trace(query, start, affectedRows(res), err)
+	}(time.Now())
+	return sqlx.NamedExecContext(ctx, db.execer(ctx), query, arg)
+}
+
+// Close 关闭主库和所有从库
+func (db *MysqlDB) Close() error {
+	for _, r := range db.replicas {
+		_ = r.Close()
+	}
+	return db.DB.Close()
+}
+
+var (
+	mysqlMu      sync.RWMutex
+	mysqlClients = make(map[string]*MysqlDB)
+)
+
+// RegisterMysql 注册一个命名的数据库连接，同名会覆盖；连接失败直接panic
+func RegisterMysql(name string, cfg MysqlConfig) *MysqlDB {
 	if ENV == "local-docker" {
-		host = HostDockerInternal
+		cfg.Host = HostDockerInternal
+		cfg.Replicas = nil
 	}
-	dsn := (&mysql.Config{
-		User:                 user,
-		Passwd:               pass,
-		Net:                  "tcp",
-		Addr:                 host + ":3306",
-		DBName:               dbName,
-		Collation:            "utf8mb4_unicode_ci",
-		AllowNativePasswords: true,
-	}).FormatDSN()
 
-	mysqlClient = sqlx.MustOpen("mysql", dsn)
+	master, err := cfg.open(cfg.Host)
+	if err != nil {
+		panic(err)
+	}
+	db := &MysqlDB{DB: master, Name: name}
+	for _, host := range cfg.Replicas {
+		replica, err := cfg.open(host)
+		if err != nil {
+			_ = db.Close()
+			panic(err)
+		}
+		db.replicas = append(db.replicas, replica)
+	}
+
+	mysqlMu.Lock()
+	mysqlClients[name] = db
+	mysqlMu.Unlock()
+	return db
+}
+
+// DB 获取命名的数据库连接，未注册时返回nil
+func DB(name string) *MysqlDB {
+	mysqlMu.RLock()
+	defer mysqlMu.RUnlock()
+	return mysqlClients[name]
+}
+
+func ShengcaiMysql(user, pass, host, dbName string) {
+	RegisterMysql(DefaultDB, MysqlConfig{User: user, Pass: pass, Host: host, DBName: dbName})
 }
 
 func NewMysql(user, pass, host, dbName string) {
 	if ENV == "local" {
 		host = "127.0.0.1"
-	} else if ENV == "local-docker" {
-		host = HostDockerInternal
 	}
-	dsn := (&mysql.Config{
-		User:                 user,
-		Passwd:               pass,
-		Net:                  "tcp",
-		Addr:                 host + ":3306",
-		DBName:               dbName,
-		Collation:            "utf8mb4_unicode_ci",
-		AllowNativePasswords: true,
-	}).FormatDSN()
-
-	mysqlClient = sqlx.MustOpen("mysql", dsn)
+	RegisterMysql(DefaultDB, MysqlConfig{User: user, Pass: pass, Host: host, DBName: dbName})
 }
 
+// GetDB 获取默认数据库的主库连接，未注册时返回nil；需要读写分离和事务传递时使用GetMysqlDB
 func GetDB() *sqlx.DB {
-	return mysqlClient
+	if db := GetMysqlDB(); db != nil {
+		return db.DB
+	}
+	return nil
+}
+
+// GetMysqlDB 获取默认数据库连接
+func GetMysqlDB() *MysqlDB {
+	return DB(DefaultDB)
 }
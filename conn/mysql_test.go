@@ -0,0 +1,123 @@
+package conn
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestMysqlConfig_dsn(t *testing.T) {
+	dsn := MysqlConfig{
+		User:        "root",
+		Pass:        "pass",
+		Host:        "127.0.0.1",
+		DBName:      "test",
+		Timeout:     3 * time.Second,
+		ReadTimeout: 10 * time.Second,
+		ParseTime:   true,
+		Params:      map[string]string{"time_zone": "'+08:00'"},
+	}.dsn("127.0.0.1")
+	want := "root:pass@tcp(127.0.0.1:3306)/test?collation=utf8mb4_unicode_ci&parseTime=true&readTimeout=10s&timeout=3s&time_zone=%27%2B08%3A00%27"
+	if dsn != want {
+		t.Errorf("dsn = %v, want %v", dsn, want)
+	}
+}
+
+func TestMysqlDB_reader(t *testing.T) {
+	master := sqlx.MustOpen("mysql", "root@tcp(master:3306)/test")
+	replica := sqlx.MustOpen("mysql", "root@tcp(replica:3306)/test")
+	db := &MysqlDB{DB: master, replicas: []*sqlx.DB{replica}}
+
+	cases := map[string]*sqlx.DB{
+		"select * from user where id=?":                        replica,
+		"  SELECT count(*) from user":                          replica,
+		"select * from user where id=? for update":             master,
+		"update user set lang=? where id=?":                    master,
+		"insert into user (uuid) values (?)":                   master,
+		"select * from user where id=? lock in share mode":     master,
+		"select * from user where id=? for share":              master,
+		"select * from user where id=? FOR UPDATE NOWAIT":      master,
+		"select * from user where id=? for update skip locked": master,
+		"select * from user where id=? for update;":            master,
+		"select * from user u for share of u nowait":           master,
+		"select * from user\nwhere id=?\nfor\tupdate":          master,
+		"select * from user where forupdate=?":                 replica,
+	}
+	for query, want := range cases {
+		if got := db.reader(query); got != want {
+			t.Errorf("reader(%q) routed to wrong connection", query)
+		}
+	}
+}
+
+func TestIsRetryableTxErr(t *testing.T) {
+	cases := map[error]bool{
+		&mysql.MySQLError{Number: 1213}:                                 true,
+		&mysql.MySQLError{Number: 1205}:                                 true,
+		&mysql.MySQLError{Number: 1062}:                                 false,
+		fmt.Errorf("put purchase: %w", &mysql.MySQLError{Number: 1213}): true,
+		errors.New("deadlock"):                                          false,
+	}
+	for err, want := range cases {
+		if got := IsRetryableTxErr(err); got != want {
+			t.Errorf("IsRetryableTxErr(%v) = %v, want %v", err, got, want)
+		}
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	cases := map[string]string{
+		"select * from user where id=?":                                   "select * from user where id=?",
+		"SELECT *  FROM user\n\tWHERE id = 42 and name='a''b' /* hint */": "select * from user where id = ? and name=?",
+		"select * from purchase where user_id in (1, 2, 3)":               "select * from purchase where user_id in (?+)",
+		"insert into t (a, b) values (?,?), (?,?), (?,?)":                 "insert into t (a, b) values (?+)",
+		"insert ignore into purchase (user_id) values (:user_id)":         "insert ignore into purchase (user_id) values (?+)",
+	}
+	for query, want := range cases {
+		if got := Fingerprint(query); got != want {
+			t.Errorf("Fingerprint(%q) = %q, want %q", query, got, want)
+		}
+	}
+}
+
+func TestFingerprintLabel(t *testing.T) {
+	defer func(n int) { MaxFingerprints = n }(MaxFingerprints)
+	MaxFingerprints = 2
+	for i, want := range []string{"a", "b", "other", "a"} {
+		fp := []string{"a", "b", "c", "a"}[i]
+		if got := fingerprintLabel("label_test", fp); got != want {
+			t.Errorf("fingerprintLabel(%q) = %q, want %q", fp, got, want)
+		}
+	}
+}
+
+func TestQueryOp(t *testing.T) {
+	cases := map[string]string{
+		"select * from user":                   "select",
+		"  INSERT into t values (?)":           "insert",
+		"(select 1) union (select 2)":          "select",
+		"update\tuser set a=?":                 "update",
+		"set names utf8mb4":                    "other",
+		"with t as (select 1) select * from t": "with",
+	}
+	for query, want := range cases {
+		if got := queryOp(query); got != want {
+			t.Errorf("queryOp(%q) = %q, want %q", query, got, want)
+		}
+	}
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if err := RegisterMetrics(reg); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterMetrics(reg); err != nil {
+		t.Errorf("register twice: %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package conn
+
+import (
+	"database/sql"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/scys-devs/lib-go"
+)
+
+var (
+	SlowQueryThreshold = 500 * time.Millisecond // 超过阈值的语句写入慢查询日志，<=0 不记录
+	QueryHook          func(stat QueryStat)     // 每条语句执行后回调，可以自行采样记录
+	MaxFingerprints    = 500                    // 指标中每个库最多记录的指纹数量，超过后记为other，避免拼接sql导致label无限增长
+
+	sqlLogger = lib.GetLogger("sql")
+
+	queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mysql_query_duration_seconds",
+		Help:    "MySQL query duration in seconds, labeled by statement type and normalized query.",
+		Buckets: []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"db", "op", "fingerprint"})
+
+	fpMu   sync.Mutex
+	fpSeen = make(map[string]map[string]bool) // 库 -> 已经作为label的指纹
+)
+
+// QueryStat 单条语句的执行信息
+type QueryStat struct {
+	DB          string
+	Query       string
+	Fingerprint string
+	Duration    time.Duration
+	Rows        int64 // 查询返回行数或者影响行数，-1 表示未知
+	Caller      string
+	Err         error
+}
+
+var (
+	fpString  = regexp.MustCompile(`'(?:[^'\\]|\\.|'')*'|"(?:[^"\\]|\\.)*"`)
+	fpNumber  = regexp.MustCompile(`\b-?\d+(?:\.\d+)?\b`)
+	fpInList  = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)*\s*\)`)
+	fpValues  = regexp.MustCompile(`(values\s*\(\?\+\))(?:\s*,\s*\(\?\+\))+`)
+	fpSpace   = regexp.MustCompile(`\s+`)
+	fpNamed   = regexp.MustCompile(`:\w+`)
+	fpComment = regexp.MustCompile(`/\*.*?\*/|--[^\n]*`)
+)
+
+// Fingerprint 归一化sql，去掉参数值，用于聚合统计
+// select * from user where id in (1,2,3) and name='a' => select * from user where id in (?+) and name=?
+func Fingerprint(query string) string {
+	q := fpComment.ReplaceAllString(query, " ")
+	q = strings.ToLower(q)
+	q = fpString.ReplaceAllString(q, "?")
+	q = fpNumber.ReplaceAllString(q, "?")
+	q = fpNamed.ReplaceAllString(q, "?")
+	q = fpSpace.ReplaceAllString(q, " ")
+	q = fpInList.ReplaceAllString(q, "(?+)")
+	q = fpValues.ReplaceAllString(q, "$1")
+	return strings.TrimSpace(q)
+}
+
+// 指标使用的指纹，每个库超过MaxFingerprints后新的指纹统一记为other
+func fingerprintLabel(db, fp string) string {
+	fpMu.Lock()
+	defer fpMu.Unlock()
+	seen, ok := fpSeen[db]
+	if !ok {
+		seen = make(map[string]bool)
+		fpSeen[db] = seen
+	}
+	if !seen[fp] {
+		if len(seen) >= MaxFingerprints {
+			return "other"
+		}
+		seen[fp] = true
+	}
+	return fp
+}
+
+// 语句类型，作为指标的label，取值有限；具体的sql只写入慢查询日志
+func queryOp(query string) string {
+	q := strings.TrimLeft(query, " \t\r\n(")
+	if i := strings.IndexAny(q, " \t\r\n("); i > 0 {
+		q = q[:i]
+	}
+	switch op := strings.ToLower(q); op {
+	case "select", "insert", "update", "delete", "replace", "with":
+		return op
+	}
+	return "other"
+}
+
+// 跳过conn、sqlx和database/sql内部的调用栈，找到真正发起查询的dao
+func queryCaller() string {
+	pc := make([]uintptr, 16)
+	n := runtime.Callers(3, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "github.com/scys-devs/lib-go/conn.") &&
+			!strings.HasPrefix(frame.Function, "github.com/jmoiron/sqlx") &&
+			!strings.HasPrefix(frame.Function, "database/sql") {
+			return frame.Function + ":" + lib.IntToStr(frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func (db *MysqlDB) trace(query string, start time.Time, rows int64, err error) {
+	stat := QueryStat{
+		DB:          db.Name,
+		Query:       query,
+		Fingerprint: Fingerprint(query),
+		Duration:    time.Since(start),
+		Rows:        rows,
+		Err:         err,
+	}
+	queryDuration.WithLabelValues(stat.DB, queryOp(query), fingerprintLabel(stat.DB, stat.Fingerprint)).Observe(stat.Duration.Seconds())
+
+	slow := SlowQueryThreshold > 0 && stat.Duration >= SlowQueryThreshold
+	if !slow && QueryHook == nil {
+		return
+	}
+	stat.Caller = queryCaller()
+	if slow {
+		sqlLogger.Warnw("slow query", "db", stat.DB, "fingerprint", stat.Fingerprint, "sql", stat.Query,
+			"used", stat.Duration.Seconds(), "rows", stat.Rows, "caller", stat.Caller, "err", stat.Err)
+	}
+	if QueryHook != nil {
+		QueryHook(stat)
+	}
+}
+
+func affectedRows(res sql.Result) int64 {
+	if res == nil {
+		return -1
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// 获取select结果的行数
+func destRows(dest interface{}) int64 {
+	v := reflect.ValueOf(dest)
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Slice {
+		return int64(v.Len())
+	}
+	return -1
+}
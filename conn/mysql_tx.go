@@ -0,0 +1,113 @@
+package conn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	TxRetry     = 3                     // 死锁、锁等待超时时整个事务的重试次数
+	TxRetryWait = 50 * time.Millisecond // 重试间隔，按次数递增
+)
+
+type txKey struct{}
+
+// Tx 事务对象，嵌套调用WithTx时会复用同一个事务并使用savepoint
+type Tx struct {
+	*sqlx.Tx
+	db    *MysqlDB
+	ctx   context.Context
+	depth int
+}
+
+// Context 携带当前事务的context，传给dao后MysqlDB的*Context方法会自动使用该事务
+func (tx *Tx) Context() context.Context {
+	return tx.ctx
+}
+
+// TxFromContext 获取context中的事务，没有返回nil
+func TxFromContext(ctx context.Context) *Tx {
+	tx, _ := ctx.Value(txKey{}).(*Tx)
+	return tx
+}
+
+// IsRetryableTxErr 死锁(1213)和锁等待超时(1205)可以重试整个事务
+func IsRetryableTxErr(err error) bool {
+	var e *mysql.MySQLError
+	if errors.As(err, &e) {
+		return e.Number == 1213 || e.Number == 1205
+	}
+	return false
+}
+
+// WithTx 在默认数据库上执行事务
+func WithTx(ctx context.Context, fn func(tx *Tx) error) error {
+	return GetMysqlDB().WithTx(ctx, fn)
+}
+
+// WithTx fn返回错误或panic时回滚，否则提交；ctx中已经有同库事务时使用savepoint嵌套
+func (db *MysqlDB) WithTx(ctx context.Context, fn func(tx *Tx) error) (err error) {
+	if parent := TxFromContext(ctx); parent != nil && parent.db == db {
+		return parent.savepoint(fn)
+	}
+
+	for i := 0; ; i++ {
+		err = db.runTx(ctx, fn)
+		if err == nil || i >= TxRetry || !IsRetryableTxErr(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(i+1) * TxRetryWait):
+		}
+	}
+}
+
+func (db *MysqlDB) runTx(ctx context.Context, fn func(tx *Tx) error) (err error) {
+	raw, err := db.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	tx := &Tx{Tx: raw, db: db}
+	tx.ctx = context.WithValue(ctx, txKey{}, tx)
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = raw.Rollback()
+			panic(r)
+		}
+	}()
+	if err = fn(tx); err != nil {
+		_ = raw.Rollback()
+		return err
+	}
+	return raw.Commit()
+}
+
+func (tx *Tx) savepoint(fn func(tx *Tx) error) (err error) {
+	tx.depth++
+	defer func() { tx.depth-- }()
+
+	name := fmt.Sprintf("sp_%d", tx.depth)
+	if _, err = tx.ExecContext(tx.ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			_, _ = tx.ExecContext(tx.ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(r)
+		}
+	}()
+	if err = fn(tx); err != nil {
+		_, _ = tx.ExecContext(tx.ctx, "ROLLBACK TO SAVEPOINT "+name)
+		return err
+	}
+	_, err = tx.ExecContext(tx.ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
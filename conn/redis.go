@@ -2,34 +2,117 @@ package conn
 
 import (
 	"context"
+	"crypto/tls"
+	"net"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
-	jsoniter "github.com/json-iterator/go"
 	"github.com/scys-devs/lib-go"
 )
 
-var redisClient *redis.Client
+var (
+	redisClient redis.UniversalClient
+	redisConfig RedisConfig
+)
 
-func NewRedis(host, port string) {
+// RedisConfig redis连接配置，零值字段使用go-redis的默认值
+type RedisConfig struct {
+	Addrs     []string // 单机填一个地址；sentinel和cluster填种子节点
+	Username  string
+	Password  string
+	DB        int         // cluster模式不支持
+	TLS       bool        // 开启后使用默认的tls配置
+	TLSConfig *tls.Config // 自定义证书等，优先于TLS
+	// 连接池
+	PoolSize     int
+	MinIdleConns int
+	PoolTimeout  time.Duration
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	// sentinel模式，填写MasterName后Addrs为sentinel地址
+	MasterName       string
+	SentinelUsername string
+	SentinelPassword string
+	// cluster模式，只有一个种子节点时也需要显式指定
+	Cluster bool
+}
+
+func (cfg RedisConfig) tlsConfig() *tls.Config {
+	if cfg.TLSConfig != nil {
+		return cfg.TLSConfig
+	}
+	if cfg.TLS {
+		return &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return nil
+}
+
+// Universal 转换成go-redis的配置
+func (cfg RedisConfig) Universal() *redis.UniversalOptions {
+	return &redis.UniversalOptions{
+		Addrs:            cfg.Addrs,
+		DB:               cfg.DB,
+		Username:         cfg.Username,
+		Password:         cfg.Password,
+		SentinelUsername: cfg.SentinelUsername,
+		SentinelPassword: cfg.SentinelPassword,
+		DialTimeout:      cfg.DialTimeout,
+		ReadTimeout:      cfg.ReadTimeout,
+		WriteTimeout:     cfg.WriteTimeout,
+		PoolSize:         cfg.PoolSize,
+		MinIdleConns:     cfg.MinIdleConns,
+		PoolTimeout:      cfg.PoolTimeout,
+		TLSConfig:        cfg.tlsConfig(),
+		MasterName:       cfg.MasterName,
+	}
+}
+
+// NewRedisClient 根据配置创建客户端，不会修改全局连接
+func NewRedisClient(cfg RedisConfig) redis.UniversalClient {
+	if cfg.Cluster {
+		return redis.NewClusterClient(cfg.Universal().Cluster())
+	}
+	return redis.NewUniversalClient(cfg.Universal())
+}
+
+// NewRedisWith 初始化全局redis连接，scheduler队列、缓存等共用这个连接
+func NewRedisWith(cfg RedisConfig) redis.UniversalClient {
 	if ENV == "local-docker" {
-		host = HostDockerInternal
+		cfg.Addrs = append([]string(nil), cfg.Addrs...)
+		for i, addr := range cfg.Addrs {
+			if _, port, err := net.SplitHostPort(addr); err == nil {
+				cfg.Addrs[i] = net.JoinHostPort(HostDockerInternal, port)
+			}
+		}
 	}
-	redisClient = redis.NewClient(&redis.Options{Addr: host + ":" + port})
+	client := NewRedisClient(cfg)
 	// 连通性测试
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
 	defer cancel()
-	if _, err := redisClient.Ping(ctx).Result(); err != nil {
+	if _, err := client.Ping(ctx).Result(); err != nil {
 		panic(err)
 	}
+	redisClient = client
+	redisConfig = cfg
+	return client
 }
 
-func GetRedis() *redis.Client {
+func NewRedis(host, port string) {
+	NewRedisWith(RedisConfig{Addrs: []string{host + ":" + port}})
+}
+
+func GetRedis() redis.UniversalClient {
 	return redisClient
 }
 
+// GetRedisConfig 获取全局连接的配置，用于asynq等无法复用client的组件
+func GetRedisConfig() RedisConfig {
+	return redisConfig
+}
+
 // 精简版的接口
 func K(_key ...string) string {
 	return strings.Join(append([]string{PREFIX, "cache"}, _key...), ":")
@@ -39,36 +122,89 @@ func GetRedisKey(_key string) string {
 	return K(_key)
 }
 
-// NOTE 应该上singleflight
+// CacheOption 缓存的可选配置
+type CacheOption struct {
+	Force  bool          // 删除缓存并重新获取
+	Local  time.Duration // 进程内缓存时间，0 不开启；不会超过redis缓存时间
+	Format string        // key的格式，用于统计命中率，为空统一记为default
+	Codec  Codec         // 序列化方式，默认json
+	Tags   []string      // 写入缓存时记录到标签下，用于InvalidateTag批量删除
+	// 防击穿
+	Stale    time.Duration // 过期后在redis中多保留的时间，期间返回旧值并在后台刷新，0 不开启
+	Beta     float64       // 提前过期系数，按上次加载耗时随机提前刷新，一般为1，0 不开启
+	ErrorTTL time.Duration // new()报错后缓存错误的时间，期间不再调用new()直接返回零值，0 不开启
+}
+
 func GetCacheFromRedis[T any](_key string, ttl int64, new func() (T, error), force ...bool) (ret T) {
+	return GetCacheFromRedisWith(_key, ttl, new, CacheOption{Force: len(force) > 0 && force[0]})
+}
+
+// GetCacheFromRedisWith 未命中时同一实例内只有一个调用者执行new()，跨实例通过redis锁互斥，没抢到锁的等待缓存写入
+func GetCacheFromRedisWith[T any](_key string, ttl int64, new func() (T, error), opt CacheOption) (ret T) {
 	key := K(_key)
 
-	if len(force) > 0 && force[0] {
+	if opt.Force {
 		_ = redisClient.Del(context.TODO(), key).Err()
+		_ = redisClient.Del(context.TODO(), errKey(key)).Err()
+		invalidateLocal(key)
 		ret, _ = new()
 		return ret
 	}
 
-	v := redisClient.Get(context.TODO(), key).Val()
+	if opt.Local > 0 {
+		var ok bool
+		if ret, ok = getLocal[T](key); ok {
+			cacheRequests.WithLabelValues(opt.format(), "local", "hit").Inc()
+			return
+		}
+		cacheRequests.WithLabelValues(opt.format(), "local", "miss").Inc()
+	}
+
+	if ttl == 86400 { // 尝试将缓存时间放到半夜
+		ttl = lib.NextDayWithOffset(7200)
+	}
+	v, remain := readCache(key, opt)
 	if len(v) == 0 {
+		cacheRequests.WithLabelValues(opt.format(), "redis", "miss").Inc()
 		var err error
-		ret, err = new()
-
-		if err != nil { // 报错了就不缓存了
+		if ret, err = loadCache(_key, ttl, new, opt); err != nil { // 报错了就不缓存了
 			return ret
 		}
-		s, _ := jsoniter.MarshalToString(ret)
-		if ttl == 86400 { // 尝试将缓存时间放到半夜
-			ttl = lib.NextDayWithOffset(7200)
-		}
-		expire := time.Duration(ttl) * time.Second
-		_ = redisClient.Set(context.TODO(), key, s, expire).Val()
 	} else {
-		_ = jsoniter.UnmarshalFromString(v, &ret)
+		cacheRequests.WithLabelValues(opt.format(), "redis", "hit").Inc()
+		_ = Unmarshal(v, &ret)
+		if opt.needRefresh(key, remain) {
+			refreshCache(_key, ttl, new, opt)
+		}
+	}
+
+	if opt.Local > 0 {
+		setLocal(key, ret, lib.Min(opt.Local, time.Duration(ttl)*time.Second))
 	}
 	return
 }
 
+// SetCache 按opt的编码写入缓存并记录标签，ttl单位秒；开启Stale时redis中多保留Stale
+func SetCache(_key string, v any, ttl int64, opt CacheOption) error {
+	b, err := opt.Codec.Marshal(v)
+	if err != nil {
+		return err
+	}
+	expire := time.Duration(ttl)*time.Second + opt.Stale
+	if err = redisClient.Set(context.TODO(), K(_key), b, expire).Err(); err != nil {
+		return err
+	}
+	return addTags(_key, int64(expire/time.Second), opt.Tags)
+}
+
+// DelCache 删除缓存和缓存的错误，同时通知所有实例清理进程内缓存
+func DelCache(_key string) {
+	key := K(_key)
+	_ = redisClient.Del(context.TODO(), key).Err()
+	_ = redisClient.Del(context.TODO(), errKey(key)).Err()
+	invalidateLocal(key)
+}
+
 // 每日巡检开关，有值就写入巡检，无值就返回是否巡检
 // daemon状态不生效
 func DayPatrol(key string, val ...interface{}) bool {
@@ -78,7 +214,7 @@ func DayPatrol(key string, val ...interface{}) bool {
 	if len(val) == 0 {
 		return len(GetRedis().Get(context.TODO(), GetRedisKey(key)).Val()) > 0
 	} else {
-		GetRedis().Set(context.TODO(), GetRedisKey(key), 1, time.Duration(lib.DaysAfter(1)-time.Now().Unix())*time.Second)
+		GetRedis().Set(context.TODO(), GetRedisKey(key), 1, time.Duration(lib.DaysAfter(1)-lib.Now().Unix())*time.Second)
 		return false
 	}
 }
@@ -0,0 +1,172 @@
+package conn
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math"
+	mrand "math/rand"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	CacheLockTTL  = 10 * time.Second      // 跨实例加载锁的过期时间，应大于new()的耗时，<=0 不加锁
+	CacheLockWait = 3 * time.Second       // 没抢到锁时等待其他实例写入缓存的最长时间，超时后自己加载
+	CachePollWait = 50 * time.Millisecond // 等待期间查询缓存的间隔
+
+	cacheGroup singleflight.Group
+	cacheDelta sync.Map // key -> 最近一次new()的耗时，用于提前过期
+)
+
+// ErrCachedError 命中了缓存的错误，ErrorTTL内不会再调用new()
+var ErrCachedError = errors.New("cache: cached error")
+
+var cacheUnlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+func errKey(key string) string {
+	return key + ":err"
+}
+
+func lockKey(key string) string {
+	return key + ":lock"
+}
+
+// 开启Stale或者Beta时需要剩余时间，一次pipeline取回；remain是逻辑上的剩余有效期，<=0 表示已经过期
+func readCache(key string, opt CacheOption) (v []byte, remain time.Duration) {
+	if opt.Stale <= 0 && opt.Beta <= 0 {
+		v, _ = redisClient.Get(context.TODO(), key).Bytes()
+		return v, 0
+	}
+	pipe := redisClient.Pipeline()
+	get := pipe.Get(context.TODO(), key)
+	pttl := pipe.PTTL(context.TODO(), key)
+	_, _ = pipe.Exec(context.TODO())
+	v, _ = get.Bytes()
+	if ttl := pttl.Val(); ttl < 0 {
+		remain = time.Duration(math.MaxInt64) // 没有过期时间
+	} else {
+		remain = ttl - opt.Stale
+	}
+	return v, remain
+}
+
+// 过期进入Stale阶段，或者按XFetch算法随机提前刷新
+func (opt CacheOption) needRefresh(key string, remain time.Duration) bool {
+	if opt.Stale > 0 && remain <= 0 {
+		return true
+	}
+	if opt.Beta <= 0 {
+		return false
+	}
+	delta, ok := cacheDelta.Load(key)
+	if !ok {
+		return false // 本实例还没有加载过，不知道耗时
+	}
+	return refreshEarly(remain, delta.(time.Duration), opt.Beta, mrand.Float64())
+}
+
+// XFetch: 剩余时间 <= 耗时 * beta * -ln(rand) 时刷新，越接近过期、加载越慢，提前刷新的概率越大
+func refreshEarly(remain, delta time.Duration, beta, r float64) bool {
+	return float64(remain) <= float64(delta)*beta*-math.Log(r)
+}
+
+// 未命中时同步加载，同一个key在本实例只有一个调用者执行
+func loadCache[T any](_key string, ttl int64, new func() (T, error), opt CacheOption) (ret T, err error) {
+	v, err, _ := cacheGroup.Do(K(_key), func() (any, error) {
+		return loadShared(_key, ttl, new, opt, true)
+	})
+	if err != nil || v == nil { // 接口类型的nil
+		return ret, err
+	}
+	if cached, ok := v.(T); ok {
+		return cached, nil
+	}
+	return new() // 同一个key用了不同的类型
+}
+
+// 后台刷新，调用方直接返回旧值；其他实例正在刷新时跳过
+func refreshCache[T any](_key string, ttl int64, new func() (T, error), opt CacheOption) {
+	cacheGroup.DoChan("refresh:"+K(_key), func() (any, error) {
+		return loadShared(_key, ttl, new, opt, false)
+	})
+}
+
+func loadShared[T any](_key string, ttl int64, new func() (T, error), opt CacheOption, wait bool) (any, error) {
+	key := K(_key)
+	if opt.ErrorTTL > 0 {
+		if msg, _ := redisClient.Get(context.TODO(), errKey(key)).Result(); len(msg) > 0 {
+			return nil, ErrCachedError
+		}
+	}
+
+	token, locked, err := lockCache(key)
+	if locked {
+		defer unlockCache(key, token)
+	} else if err == nil && CacheLockTTL > 0 {
+		if !wait {
+			return nil, nil
+		}
+		// 其他实例正在加载，等它写入
+		if v, ok := waitCache(key); ok {
+			var ret T
+			if err := Unmarshal(v, &ret); err == nil {
+				return ret, nil
+			}
+		}
+	}
+
+	start := time.Now()
+	ret, err := new()
+	cacheDelta.Store(key, time.Since(start))
+	if err != nil {
+		if opt.ErrorTTL > 0 {
+			_ = redisClient.Set(context.TODO(), errKey(key), err.Error(), opt.ErrorTTL).Err()
+		}
+		return nil, err
+	}
+	_ = SetCache(_key, ret, ttl, opt)
+	return ret, nil
+}
+
+// redis出错时返回err，调用方不再等待，直接加载
+func lockCache(key string) (token string, ok bool, err error) {
+	if CacheLockTTL <= 0 {
+		return "", false, nil
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	token = hex.EncodeToString(b)
+	if ok, err = redisClient.SetNX(context.TODO(), lockKey(key), token, CacheLockTTL).Result(); err != nil {
+		cacheLogger.Errorw("cache lock", "key", key, "err", err)
+	}
+	return token, ok, err
+}
+
+func unlockCache(key, token string) {
+	_ = cacheUnlockScript.Run(context.TODO(), redisClient, []string{lockKey(key)}, token).Err()
+}
+
+// 缓存写入后返回；锁释放了还没有写入说明对方失败了，不再等待
+func waitCache(key string) ([]byte, bool) {
+	deadline := time.Now().Add(CacheLockWait)
+	for time.Now().Before(deadline) {
+		time.Sleep(CachePollWait)
+		if v, _ := redisClient.Get(context.TODO(), key).Bytes(); len(v) > 0 {
+			return v, true
+		}
+		if n, _ := redisClient.Exists(context.TODO(), lockKey(key)).Result(); n == 0 {
+			return nil, false
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,68 @@
+package conn
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRefreshEarly(t *testing.T) {
+	cases := []struct {
+		remain, delta time.Duration
+		r             float64
+		want          bool
+	}{
+		{time.Minute, 100 * time.Millisecond, 0.5, false},
+		{50 * time.Millisecond, 100 * time.Millisecond, 0.5, true}, // -ln(0.5)≈0.69
+		{80 * time.Millisecond, 100 * time.Millisecond, 0.5, false},
+		{time.Hour, time.Millisecond, math.SmallestNonzeroFloat64, false},
+		{0, time.Millisecond, 0.99, true},
+	}
+	for _, c := range cases {
+		if got := refreshEarly(c.remain, c.delta, 1, c.r); got != c.want {
+			t.Errorf("refreshEarly(%v, %v, %v) = %v", c.remain, c.delta, c.r, got)
+		}
+	}
+}
+
+func TestCacheOption_needRefresh(t *testing.T) {
+	key := K("test", "needRefresh")
+	defer cacheDelta.Delete(key)
+
+	if (CacheOption{}).needRefresh(key, -time.Second) {
+		t.Error("refresh without Stale or Beta")
+	}
+	if !(CacheOption{Stale: time.Minute}).needRefresh(key, -time.Second) {
+		t.Error("no refresh in stale window")
+	}
+	if (CacheOption{Stale: time.Minute}).needRefresh(key, time.Second) {
+		t.Error("refresh before expiry")
+	}
+	if (CacheOption{Beta: 1}).needRefresh(key, 0) {
+		t.Error("early refresh without load duration")
+	}
+	cacheDelta.Store(key, time.Hour)
+	if !(CacheOption{Beta: 1}).needRefresh(key, 0) {
+		t.Error("no early refresh at expiry")
+	}
+}
+
+// 返回nil接口时不能再加载一次；redis不可用时直接加载
+func TestLoadCache_nil(t *testing.T) {
+	old := redisClient
+	redisClient = NewRedisClient(RedisConfig{Addrs: []string{"127.0.0.1:1"}})
+	defer func() {
+		_ = redisClient.Close()
+		redisClient = old
+	}()
+
+	var calls int
+	v, err := loadCache("test:nil", 60, func() (fmt.Stringer, error) {
+		calls++
+		return nil, nil
+	}, CacheOption{})
+	if v != nil || err != nil || calls != 1 {
+		t.Errorf("loadCache = %v, %v, calls %v", v, err, calls)
+	}
+}
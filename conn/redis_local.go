@@ -0,0 +1,87 @@
+package conn
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/scys-devs/lib-go"
+)
+
+var (
+	LocalCacheSize = 10000 // 进程内缓存最多的key数量，需要在第一次使用前设置
+
+	localCache     atomic.Pointer[lib.LRU[string, any]]
+	localCacheOnce sync.Once
+	cacheLogger    = lib.GetLogger("cache")
+
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_requests_total",
+		Help: "Cache lookups by key format, tier (local/redis) and result (hit/miss).",
+	}, []string{"format", "tier", "result"})
+)
+
+func (opt CacheOption) format() string {
+	if len(opt.Format) == 0 {
+		return "default"
+	}
+	return opt.Format
+}
+
+// 用于广播失效的频道
+func invalidateChannel() string {
+	return K("invalidate")
+}
+
+// 第一次使用进程内缓存时才订阅失效消息
+func initLocalCache() {
+	localCacheOnce.Do(func() {
+		lru := lib.NewLRU[string, any](LocalCacheSize, 0)
+		localCache.Store(lru)
+		go func() {
+			for {
+				pubsub := redisClient.Subscribe(context.Background(), invalidateChannel())
+				for msg := range pubsub.Channel() {
+					lru.Delete(msg.Payload)
+				}
+				// 连接关闭，丢失的消息无法补偿，清空后重新订阅
+				_ = pubsub.Close()
+				lru.Purge()
+				cacheLogger.Warnw("invalidate subscription closed, resubscribe")
+				time.Sleep(time.Second)
+			}
+		}()
+	})
+}
+
+func getLocal[T any](key string) (ret T, ok bool) {
+	initLocalCache()
+	v, ok := localCache.Load().Get(key)
+	if !ok {
+		return
+	}
+	ret, ok = v.(T)
+	return
+}
+
+func setLocal(key string, v any, ttl time.Duration) {
+	initLocalCache()
+	localCache.Load().SetWithTTL(key, v, ttl)
+}
+
+// 当前实例立即清理，其他实例通过pub/sub清理
+func invalidateLocal(key string) {
+	if lru := localCache.Load(); lru != nil {
+		lru.Delete(key)
+	}
+	if err := redisClient.Publish(context.TODO(), invalidateChannel(), key).Err(); err != nil {
+		cacheLogger.Errorw("publish invalidate", "key", key, "err", err)
+	}
+}
+
+// InvalidateLocalCache 缓存在redis中更新后，通知所有实例丢弃进程内的旧值
+func InvalidateLocalCache(_key string) {
+	invalidateLocal(K(_key))
+}
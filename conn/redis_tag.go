@@ -0,0 +1,109 @@
+package conn
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// TagPruneEvery 平均每写入多少次检查一次标签，清理已经过期的成员
+var TagPruneEvery = 100
+
+// 标签集合的过期时间取成员中最长的缓存时间，有不过期的成员时标签也不过期
+var addTagScript = redis.NewScript(`
+local ttl = redis.call('TTL', KEYS[1])
+redis.call('SADD', KEYS[1], ARGV[1])
+local expire = tonumber(ARGV[2])
+if expire <= 0 then
+	redis.call('PERSIST', KEYS[1])
+elseif ttl == -2 or (ttl >= 0 and ttl < expire) then
+	redis.call('EXPIRE', KEYS[1], expire)
+end
+return 1
+`)
+
+func tagKey(tag string) string {
+	return K("tag", tag)
+}
+
+func addTags(_key string, ttl int64, tags []string) error {
+	for _, tag := range tags {
+		if err := addTagScript.Run(context.TODO(), redisClient, []string{tagKey(tag)}, _key, ttl).Err(); err != nil {
+			return err
+		}
+		if TagPruneEvery > 0 && rand.Intn(TagPruneEvery) == 0 {
+			go func(tag string) {
+				if _, err := PruneTag(tag); err != nil {
+					cacheLogger.Errorw("prune tag", "tag", tag, "err", err)
+				}
+			}(tag)
+		}
+	}
+	return nil
+}
+
+// PruneTag 从标签中移除已经过期的缓存key，返回移除的数量
+func PruneTag(tag string) (removed int, err error) {
+	ctx := context.TODO()
+	var cursor uint64
+	for {
+		var members []string
+		if members, cursor, err = redisClient.SScan(ctx, tagKey(tag), cursor, "", 100).Result(); err != nil {
+			return
+		}
+		// cluster模式下key可能不在一个slot，逐个检查
+		pipe := redisClient.Pipeline()
+		exists := make([]*redis.IntCmd, len(members))
+		for i, _key := range members {
+			exists[i] = pipe.Exists(ctx, K(_key))
+		}
+		if _, err = pipe.Exec(ctx); err != nil {
+			return
+		}
+		var dead []interface{}
+		for i, cmd := range exists {
+			if cmd.Val() == 0 {
+				dead = append(dead, members[i])
+			}
+		}
+		if len(dead) > 0 {
+			if err = redisClient.SRem(ctx, tagKey(tag), dead...).Err(); err != nil {
+				return
+			}
+			removed += len(dead)
+		}
+		if cursor == 0 {
+			return removed, nil
+		}
+	}
+}
+
+// TagMembers 获取标签下记录的缓存key
+func TagMembers(tag string) ([]string, error) {
+	return redisClient.SMembers(context.TODO(), tagKey(tag)).Result()
+}
+
+// InvalidateTag 删除标签下的所有缓存和标签本身，返回被删除的key
+func InvalidateTag(tags ...string) (keys []string, err error) {
+	for _, tag := range tags {
+		members, err := TagMembers(tag)
+		if err != nil {
+			return keys, err
+		}
+		// cluster模式下key可能不在一个slot，逐个删除
+		pipe := redisClient.Pipeline()
+		for _, _key := range members {
+			pipe.Del(context.TODO(), K(_key))
+		}
+		pipe.Del(context.TODO(), tagKey(tag))
+		if _, err = pipe.Exec(context.TODO()); err != nil {
+			return keys, err
+		}
+		for _, _key := range members {
+			invalidateLocal(K(_key))
+		}
+		keys = append(keys, members...)
+	}
+	return keys, nil
+}
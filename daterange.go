@@ -0,0 +1,221 @@
+package lib
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateRange 前端传入的时间范围，逗号分隔开始和结束，结束时间包含在内；支持的写法：
+//
+//	1727712000,1727798399          unix秒或者毫秒，amis date-range 默认格式
+//	2024-10-01,2024-10-07          ISO 8601 日期或时间，只有日期的结束时间取当天最后一秒
+//	-7d,now  now-1M/M,now/M        相对现在，单位 s m h d w M y，/单位 表示取整到这个单位
+//	today  yesterday  thisWeek  lastWeek  thisMonth  lastMonth  thisYear  lastYear
+//
+// 为空时是今天；单个值表示它所在的时间段，例如 2024-10-01 是一整天
+type DateRange string
+
+// 时间段，end 包含在内
+type dateSpan struct {
+	start, end time.Time
+}
+
+func pointSpan(t time.Time) dateSpan {
+	return dateSpan{t, t}
+}
+
+// 从start开始的一个单位
+func unitSpan(start time.Time, unit byte) dateSpan {
+	return dateSpan{start, addUnit(start, unit, 1).Add(-time.Second)}
+}
+
+func addUnit(t time.Time, unit byte, n int) time.Time {
+	switch unit {
+	case 's':
+		return t.Add(time.Duration(n) * time.Second)
+	case 'm':
+		return t.Add(time.Duration(n) * time.Minute)
+	case 'h':
+		return t.Add(time.Duration(n) * time.Hour)
+	case 'd':
+		return t.AddDate(0, 0, n)
+	case 'w':
+		return t.AddDate(0, 0, 7*n)
+	case 'M':
+		return t.AddDate(0, n, 0)
+	default: // y
+		return t.AddDate(n, 0, 0)
+	}
+}
+
+// 取整到单位的开始
+func truncUnit(t time.Time, unit byte, loc *time.Location) time.Time {
+	t = t.In(loc)
+	switch unit {
+	case 's':
+		return t.Truncate(time.Second)
+	case 'm':
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	case 'h':
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	case 'd':
+		return StartOfDay(t, loc)
+	case 'w':
+		return StartOfWeek(t, loc)
+	case 'M':
+		return StartOfMonth(t, loc)
+	default: // y
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+var namedRanges = map[string]func(now time.Time, loc *time.Location) dateSpan{
+	"today":     func(now time.Time, loc *time.Location) dateSpan { return unitSpan(StartOfDay(now, loc), 'd') },
+	"yesterday": func(now time.Time, loc *time.Location) dateSpan { return unitSpan(DaysAfterIn(now, -1, loc), 'd') },
+	"thisWeek":  func(now time.Time, loc *time.Location) dateSpan { return unitSpan(StartOfWeek(now, loc), 'w') },
+	"lastWeek": func(now time.Time, loc *time.Location) dateSpan {
+		return unitSpan(StartOfWeek(now, loc).AddDate(0, 0, -7), 'w')
+	},
+	"thisMonth": func(now time.Time, loc *time.Location) dateSpan { return unitSpan(StartOfMonth(now, loc), 'M') },
+	"lastMonth": func(now time.Time, loc *time.Location) dateSpan {
+		return unitSpan(StartOfMonth(now, loc).AddDate(0, -1, 0), 'M')
+	},
+	"thisYear": func(now time.Time, loc *time.Location) dateSpan { return unitSpan(truncUnit(now, 'y', loc), 'y') },
+	"lastYear": func(now time.Time, loc *time.Location) dateSpan {
+		return unitSpan(truncUnit(now, 'y', loc).AddDate(-1, 0, 0), 'y')
+	},
+}
+
+// now-7d/d、-1M、now、+2h
+var relativeRegexp = regexp.MustCompile(`^(now)?(?:([+-]\d+)([smhdwMy]))?(?:/([smhdwMy]))?$`)
+
+// 只有日期或者月份的按整段计算
+var dateLayouts = []struct {
+	layout string
+	unit   byte
+}{
+	{time.RFC3339Nano, 0},
+	{"2006-01-02T15:04:05-0700", 0},
+	{"2006-01-02T15:04:05", 0},
+	{FormatTime, 0},
+	{"2006-01-02T15:04", 0},
+	{"2006-01-02 15:04", 0},
+	{FormatDay, 'd'},
+	{"20060102", 'd'},
+	{"2006-01", 'M'},
+}
+
+func parseDatePart(v string, now time.Time, loc *time.Location) (dateSpan, error) {
+	if f, ok := namedRanges[v]; ok {
+		return f(now, loc), nil
+	}
+	if m := relativeRegexp.FindStringSubmatch(v); m != nil && len(v) > 0 {
+		t := now.In(loc)
+		if len(m[2]) > 0 {
+			n, _ := strconv.Atoi(m[2])
+			t = addUnit(t, m[3][0], n)
+		}
+		if len(m[4]) > 0 {
+			return unitSpan(truncUnit(t, m[4][0], loc), m[4][0]), nil
+		}
+		return pointSpan(t), nil
+	}
+	if len(v) != 8 { // 20060102 按日期解析
+		if ts, err := strconv.ParseInt(v, 10, 64); err == nil {
+			if ts < 0 {
+				return dateSpan{}, fmt.Errorf("daterange: invalid unix %v", v)
+			}
+			if ts >= 1e12 { // 毫秒
+				return pointSpan(time.UnixMilli(ts).In(loc)), nil
+			}
+			return pointSpan(time.Unix(ts, 0).In(loc)), nil
+		}
+	}
+	for _, l := range dateLayouts {
+		if t, err := time.ParseInLocation(l.layout, v, loc); err == nil {
+			if l.unit == 0 {
+				return pointSpan(t), nil
+			}
+			return unitSpan(t, l.unit), nil
+		}
+	}
+	return dateSpan{}, fmt.Errorf("daterange: unknown value %q", v)
+}
+
+// ParseIn 按loc时区解析，返回的结束时间包含在内
+func (item DateRange) ParseIn(loc *time.Location) (start, end time.Time, err error) {
+	now := Now()
+	parts := strings.Split(strings.TrimSpace(string(item)), ",")
+	if len(parts) > 2 {
+		return start, end, fmt.Errorf("daterange: too many values %q", item)
+	}
+	if len(parts) == 1 && len(parts[0]) == 0 {
+		parts[0] = "today"
+	}
+	first, err := parseDatePart(strings.TrimSpace(parts[0]), now, loc)
+	if err != nil {
+		return
+	}
+	last := first
+	if len(parts) == 2 {
+		if last, err = parseDatePart(strings.TrimSpace(parts[1]), now, loc); err != nil {
+			return
+		}
+	}
+	if first.start.After(last.end) {
+		return start, end, fmt.Errorf("daterange: start after end %q", item)
+	}
+	return first.start, last.end, nil
+}
+
+// Parse 按DefaultLocation解析，返回unix秒
+func (item DateRange) Parse() (start, end int64, err error) {
+	s, e, err := item.ParseIn(DefaultLocation)
+	if err != nil {
+		return 0, 0, err
+	}
+	return s.Unix(), e.Unix(), nil
+}
+
+// Start 解析失败时返回今天0点
+func (item DateRange) Start() int64 {
+	start, _, err := item.Parse()
+	if err != nil {
+		return DaysAfter(0)
+	}
+	return start
+}
+
+// End 解析失败时返回今天最后一秒
+func (item DateRange) End() int64 {
+	_, end, err := item.Parse()
+	if err != nil {
+		return DaysAfter(1) - 1
+	}
+	return end
+}
+
+// SQL 生成 column BETWEEN ? AND ? 以及参数，参数是unix秒
+func (item DateRange) SQL(column string) (string, []any, error) {
+	start, end, err := item.Parse()
+	if err != nil {
+		return "", nil, err
+	}
+	return column + " BETWEEN ? AND ?", []any{start, end}, nil
+}
+
+// ES 生成range查询，字段按unix秒存储
+func (item DateRange) ES(field string) (map[string]any, error) {
+	start, end, err := item.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"range": map[string]any{
+			field: map[string]any{"gte": start, "lte": end, "format": "epoch_second"},
+		},
+	}, nil
+}
@@ -0,0 +1,46 @@
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateRange_Parse(t *testing.T) {
+	loc := DefaultLocation
+	now := time.Date(2024, 10, 16, 15, 30, 0, 0, loc) // 周三
+	defer SetClock(FixedClock(now))()
+
+	day := func(m time.Month, d int) int64 { return time.Date(2024, m, d, 0, 0, 0, 0, loc).Unix() }
+	cases := []struct {
+		in         DateRange
+		start, end int64
+	}{
+		{"", day(10, 16), day(10, 17) - 1},
+		{"1727712000,1727798399", 1727712000, 1727798399},
+		{"1727712000000,1727798399999", 1727712000, 1727798399},
+		{"2024-10-01,2024-10-07", day(10, 1), day(10, 8) - 1},
+		{"2024-10-01T08:00:00+08:00,now", day(10, 1) + 8*3600, now.Unix()},
+		{"-7d,now", now.AddDate(0, 0, -7).Unix(), now.Unix()},
+		{"now-7d/d,now/d", day(10, 9), day(10, 17) - 1},
+		{"yesterday", day(10, 15), day(10, 16) - 1},
+		{"thisWeek", day(10, 14), day(10, 21) - 1},
+		{"lastMonth", day(9, 1), day(10, 1) - 1},
+		{"2024-10", day(10, 1), day(11, 1) - 1},
+		{"20241001", day(10, 1), day(10, 2) - 1},
+	}
+	for _, c := range cases {
+		start, end, err := c.in.Parse()
+		if err != nil || start != c.start || end != c.end {
+			t.Errorf("%q = %v, %v, %v, want %v, %v", c.in, start, end, err, c.start, c.end)
+		}
+	}
+
+	for _, in := range []DateRange{"abc", "1,2,3", "now,-7d", "-1"} {
+		if _, _, err := in.Parse(); err == nil {
+			t.Errorf("%q should fail", in)
+		}
+	}
+	if DateRange("abc").Start() != day(10, 16) {
+		t.Error("invalid range should fall back to today")
+	}
+}
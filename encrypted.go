@@ -0,0 +1,113 @@
+package lib
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EncryptedString 入库时使用DefaultKeyring加密，读取时解密，在DO里直接替换string字段，sql不需要修改；
+// 每次加密结果都不同，不能用于where条件，需要查询的字段用DeterministicString或者BlindIndex
+//
+// 空字符串不加密；密文带EncryptedPrefix前缀，没有前缀的旧数据原样返回，方便逐步迁移
+type EncryptedString string
+
+// EncryptedPrefix 入库的密文前缀，用来和明文区分
+const EncryptedPrefix = "enc:"
+
+func (s EncryptedString) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return "", nil
+	}
+	envelope, err := DefaultKeyring.Encrypt(string(s))
+	if err != nil {
+		return nil, err
+	}
+	return EncryptedPrefix + envelope, nil
+}
+
+func (s *EncryptedString) Scan(src any) error {
+	plain, err := scanEncrypted(src, DefaultKeyring.Decrypt)
+	*s = EncryptedString(plain)
+	return err
+}
+
+func (s EncryptedString) String() string {
+	return string(s)
+}
+
+// DeterministicString 相同的明文加密结果相同，可以用于 where uuid=? 和唯一索引，
+// 参数同样传DeterministicString；会暴露哪些行的值相等
+type DeterministicString string
+
+func (s DeterministicString) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return "", nil
+	}
+	envelope, err := DefaultKeyring.SealDeterministic([]byte(s), nil)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptedPrefix + envelope, nil
+}
+
+func (s *DeterministicString) Scan(src any) error {
+	plain, err := scanEncrypted(src, DefaultKeyring.Decrypt)
+	*s = DeterministicString(plain)
+	return err
+}
+
+func (s DeterministicString) String() string {
+	return string(s)
+}
+
+func scanEncrypted(src any, decrypt func(string) (string, error)) (string, error) {
+	var raw string
+	switch v := src.(type) {
+	case nil:
+		return "", nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return "", fmt.Errorf("cannot scan %T into encrypted string", src)
+	}
+	if len(raw) == 0 {
+		return "", nil
+	}
+	if strings.HasPrefix(raw, EncryptedPrefix) {
+		return decrypt(raw[len(EncryptedPrefix):])
+	}
+	if _, err := KeyID(raw); err != nil {
+		return raw, nil // 还没有加密的旧数据
+	}
+	// 没有前缀但是像信封的，可能是之前没有前缀时写入的密文，也可能是碰巧像的明文，解不开就当明文
+	if plain, err := decrypt(raw); err == nil {
+		return plain, nil
+	}
+	return raw, nil
+}
+
+// BlindIndexKey BlindIndex使用的密钥，和加密密钥分开，启动时配置，之后不能修改
+var BlindIndexKey []byte
+
+// BlindIndex 计算value的盲索引，存在单独的列里用于等值查询，不受加密密钥轮换影响；
+// 大小写、空格等需要调用方先统一
+func BlindIndex(value string) (string, error) {
+	if len(BlindIndexKey) == 0 {
+		return "", errors.New("blind index key not configured")
+	}
+	return BlindIndexWith(BlindIndexKey, value), nil
+}
+
+// BlindIndexWith HMAC-SHA256(key, value)的hex
+func BlindIndexWith(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
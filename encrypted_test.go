@@ -0,0 +1,115 @@
+package lib
+
+import (
+	"testing"
+)
+
+func withTestKeyring(t *testing.T) *Keyring {
+	k := NewKeyring()
+	if err := k.Rotate("v1", testKey(1)); err != nil {
+		t.Fatal(err)
+	}
+	old := DefaultKeyring
+	DefaultKeyring = k
+	t.Cleanup(func() { DefaultKeyring = old })
+	return k
+}
+
+func TestEncryptedString(t *testing.T) {
+	withTestKeyring(t)
+	v, err := EncryptedString("10.0.0.1").Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == "10.0.0.1" {
+		t.Fatal("value not encrypted")
+	}
+	v2, _ := EncryptedString("10.0.0.1").Value()
+	if v == v2 {
+		t.Error("random encryption returned same ciphertext")
+	}
+
+	var s EncryptedString
+	for _, c := range []struct {
+		src  any
+		want string
+	}{
+		{[]byte(v.(string)), "10.0.0.1"},
+		{v, "10.0.0.1"},
+		{nil, ""},
+		{"", ""},
+		{"192.168.1.1", "192.168.1.1"}, // 旧数据
+	} {
+		if err = s.Scan(c.src); err != nil || string(s) != c.want {
+			t.Errorf("scan %v = %q, %v", c.src, s, err)
+		}
+	}
+	if v, _ = EncryptedString("").Value(); v != "" {
+		t.Errorf("empty value = %v", v)
+	}
+}
+
+func TestEncryptedString_legacy(t *testing.T) {
+	k := withTestKeyring(t)
+	// 没有前缀的密文仍然可以读取
+	envelope, _ := k.Encrypt("token")
+	var s EncryptedString
+	if err := s.Scan(envelope); err != nil || s != "token" {
+		t.Errorf("scan unprefixed = %q, %v", s, err)
+	}
+	// 碰巧像信封的明文原样返回
+	other := NewKeyring()
+	_ = other.Rotate("v1", testKey(9))
+	lookalike, _ := other.Encrypt("x")
+	if err := s.Scan(lookalike); err != nil || string(s) != lookalike {
+		t.Errorf("scan lookalike = %q, %v", s, err)
+	}
+	// 有前缀但是解不开的报错
+	if err := s.Scan(EncryptedPrefix + lookalike); err == nil {
+		t.Errorf("scan bad ciphertext = %q", s)
+	}
+}
+
+func TestDeterministicString(t *testing.T) {
+	k := withTestKeyring(t)
+	a, _ := DeterministicString("uuid-1").Value()
+	b, _ := DeterministicString("uuid-1").Value()
+	c, _ := DeterministicString("uuid-2").Value()
+	if a != b || a == c {
+		t.Fatalf("deterministic: %v %v %v", a, b, c)
+	}
+	var s DeterministicString
+	if err := s.Scan(a); err != nil || s != "uuid-1" {
+		t.Errorf("scan = %q, %v", s, err)
+	}
+	if x, _ := k.SealDeterministic([]byte("uuid-1"), []byte("user.uuid")); EncryptedPrefix+x == a {
+		t.Error("aad ignored")
+	}
+
+	_ = k.Rotate("v2", testKey(2))
+	if d, _ := DeterministicString("uuid-1").Value(); d == a {
+		t.Error("same ciphertext after rotation")
+	}
+	if err := s.Scan(a); err != nil || s != "uuid-1" {
+		t.Errorf("scan old = %q, %v", s, err)
+	}
+}
+
+func TestBlindIndex(t *testing.T) {
+	old := BlindIndexKey
+	defer func() { BlindIndexKey = old }()
+
+	BlindIndexKey = nil
+	if _, err := BlindIndex("a"); err == nil {
+		t.Error("empty key accepted")
+	}
+	BlindIndexKey = []byte("index key")
+	a, _ := BlindIndex("13800000000")
+	b, _ := BlindIndex("13800000000")
+	if a != b || len(a) != 64 {
+		t.Errorf("blind index %v %v", a, b)
+	}
+	if a == BlindIndexWith([]byte("other"), "13800000000") {
+		t.Error("key ignored")
+	}
+}
@@ -1,14 +1,19 @@
 package lib
 
 import (
+	"bufio"
+	"compress/flate"
 	"compress/gzip"
+	"compress/zlib"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/pkg/errors"
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -16,47 +21,92 @@ import (
 var json = jsoniter.ConfigFastest
 var StatusOK = 300
 
+// DoRequest 和原来一样使用http.DefaultClient，不超时、不重试；需要的使用DefaultClient或者自己NewClient
 func DoRequest(req *http.Request) (rb []byte, err error) {
-	if req == nil {
-		return nil, errors.New("no request")
-	}
-	resp, err := http.DefaultClient.Do(req)
+	return legacyClient.DoRequest(req)
+}
+
+func DoRequestJson(req *http.Request, v interface{}) (err error) {
+	return legacyClient.DoRequestJson(req, v)
+}
+
+// 按Content-Encoding解压
+func readBody(resp *http.Response) ([]byte, error) {
+	r, err := bodyReader(resp)
 	if err != nil {
-		return
+		return nil, err
 	}
-	defer resp.Body.Close()
+	defer r.Close()
+	return io.ReadAll(r)
+}
 
-	if resp.StatusCode >= StatusOK {
-		err = errors.New(resp.Status)
-		return
+// 支持 gzip、deflate、br、zstd，多次编码时按相反顺序解压；关闭时不会关闭resp.Body
+func bodyReader(resp *http.Response) (io.ReadCloser, error) {
+	var r io.ReadCloser = io.NopCloser(resp.Body)
+	encodings := strings.Split(resp.Header.Get("Content-Encoding"), ",")
+	for i := len(encodings) - 1; i >= 0; i-- {
+		next, err := decodeReader(strings.ToLower(strings.TrimSpace(encodings[i])), r)
+		if err != nil {
+			r.Close()
+			return nil, fmt.Errorf("decode %v: %w", encodings[i], err)
+		}
+		r = next
 	}
+	return r, nil
+}
 
-	switch resp.Header.Get("Content-Encoding") {
-	case "gzip":
-		r, _ := gzip.NewReader(resp.Body)
-		defer r.Close()
-
-		return io.ReadAll(r)
+func decodeReader(encoding string, r io.ReadCloser) (io.ReadCloser, error) {
+	switch encoding {
+	case "", "identity":
+		return r, nil
+	case "gzip", "x-gzip":
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return chainCloser(gr, gr, r), nil
+	case "deflate": // 标准是zlib格式，也有服务端直接发raw deflate
+		br := bufio.NewReader(r)
+		if head, err := br.Peek(2); err == nil && head[0]&0x0f == 8 && (uint16(head[0])<<8|uint16(head[1]))%31 == 0 {
+			zr, err := zlib.NewReader(br)
+			if err != nil {
+				return nil, err
+			}
+			return chainCloser(zr, zr, r), nil
+		}
+		fr := flate.NewReader(br)
+		return chainCloser(fr, fr, r), nil
+	case "br":
+		return chainCloser(brotli.NewReader(r), r), nil
+	case "zstd":
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return chainCloser(zr, zr.IOReadCloser(), r), nil
 	default:
-		return io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
 	}
 }
 
-func DoRequestJson(req *http.Request, v interface{}) (err error) {
-	rb, err := DoRequest(req)
-	if err != nil {
-		return
-	}
-	if v == nil {
-		return
-	}
-	err = json.Unmarshal(rb, v)
-	if err != nil {
-		err = fmt.Errorf("parse json err=%s, body=%s", err.Error(), string(rb))
+type multiCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (m multiCloser) Close() (err error) {
+	for _, c := range m.closers {
+		if e := c.Close(); e != nil && err == nil {
+			err = e
+		}
 	}
 	return
 }
 
+func chainCloser(r io.Reader, closers ...io.Closer) io.ReadCloser {
+	return multiCloser{Reader: r, closers: closers}
+}
+
 func QueryValues(query url.Values) []string {
 	val := make([]string, len(query))
 	for key := range query {
@@ -110,58 +160,13 @@ func ParseFirstHostUrl(raw string) string {
 	return hostLL[0]
 }
 
-// 获取302重定向的地址; 如果不是302的话，就返回空
+// 获取302重定向的地址; 如果不是3xx的话，就返回空；需要meta、js跳转和完整链路的使用ResolveRedirect
 func GetRedirectURL(raw string) string {
-	var redirectCount = 0
-	myRedirect := func(req *http.Request, via []*http.Request) (e error) {
-		redirectCount++
-		if redirectCount == 1 {
-			redirectCount = 0
-			return errors.New(req.URL.String())
-		}
-		return
-	}
-
-	client := &http.Client{CheckRedirect: myRedirect}
-	_, err := client.Get(raw)
-	if err != nil { // 报错了就是需要重定向了
-		if e, ok := err.(*url.Error); ok && e.Err != nil {
-			return e.URL
-		}
-	}
-	return ""
+	hop, _ := RedirectResolver{LocationOnly: true}.Hop(context.Background(), raw)
+	return hop.Next
 }
 
-// 新的http请求方法，对异常进行return
+// 新的http请求方法，对异常进行return；错误信息是body，可以用errors.As取出HTTPError
 func DoRequestHasErrMsg(req *http.Request) (rb []byte, err error) {
-	if req == nil {
-		return nil, errors.New("no request")
-	}
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return
-	}
-	defer resp.Body.Close()
-
-	httpResponseStatus := resp.StatusCode >= 300
-
-	switch resp.Header.Get("Content-Encoding") {
-	case "gzip":
-		r, _ := gzip.NewReader(resp.Body)
-		defer r.Close()
-		respTmp, errTmp := io.ReadAll(r)
-
-		if httpResponseStatus {
-			err = errors.New(string(respTmp))
-			return
-		}
-		return respTmp, errTmp
-	default:
-		respTmp, errTmp := io.ReadAll(resp.Body)
-		if httpResponseStatus {
-			err = errors.New(string(respTmp))
-			return
-		}
-		return respTmp, errTmp
-	}
+	return legacyClient.DoRequestHasErrMsg(req)
 }
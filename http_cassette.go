@@ -0,0 +1,333 @@
+package lib
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 保持数字精度，map按key排序，文件内容稳定
+var cassetteJSON = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// ErrCassetteMiss 回放时没有匹配的记录
+var ErrCassetteMiss = errors.New("cassette: no matching interaction")
+
+// CassetteMode 录制还是回放
+type CassetteMode int
+
+const (
+	CassetteAuto   CassetteMode = iota // 文件存在时回放，不存在时录制
+	CassetteReplay                     // 只回放，没有匹配的返回ErrCassetteMiss
+	CassetteRecord                     // 重新录制，覆盖原来的文件
+)
+
+// Redacted 脱敏后的值
+const Redacted = "REDACTED"
+
+// DefaultRedactHeaders 默认脱敏的header
+var DefaultRedactHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// Cassette 测试用的RoundTripper，录制外部请求到文件，之后离线回放；
+// 通过SetTransport替换DoRequest等函数使用的客户端，或者传给ClientConfig.Transport
+//
+// 按method、URL(query不分顺序)和body匹配，json的body按内容比较；相同的请求按录制顺序回放
+type Cassette struct {
+	Path         string
+	Mode         CassetteMode
+	RedactHeader []string                                              // 脱敏的header，为空时使用DefaultRedactHeaders
+	RedactField  []string                                              // 脱敏的query参数和json字段，任意层级同名的都会替换
+	Match        func(req *CassetteRequest, rec *CassetteRequest) bool // 自定义匹配，为空时使用默认规则
+	Transport    http.RoundTripper                                     // 录制时实际发送请求，为空时使用http.DefaultTransport
+
+	mu           sync.Mutex
+	recording    bool
+	interactions []*Interaction
+	used         map[*Interaction]bool
+}
+
+// Interaction 一次请求和响应
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+type CassetteRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+	Base64 bool        `json:"base64,omitempty"` // body不是utf8时base64编码
+}
+
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	Base64     bool        `json:"base64,omitempty"`
+}
+
+// NewCassette 读取已有的记录，CassetteAuto 模式下文件不存在时开始录制
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{Path: path, Mode: mode, used: make(map[*Interaction]bool)}
+	if mode == CassetteRecord {
+		c.recording = true
+		return c, nil
+	}
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && mode == CassetteAuto {
+		c.recording = true
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = cassetteJSON.Unmarshal(raw, &c.interactions); err != nil {
+		return nil, fmt.Errorf("cassette %v: %w", path, err)
+	}
+	return c, nil
+}
+
+// Recording 是否正在录制
+func (c *Cassette) Recording() bool {
+	return c.recording
+}
+
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	cr := c.request(req, body)
+	if c.recording {
+		if body != nil { // 不修改调用方的req
+			req = req.Clone(req.Context())
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		return c.record(req, cr)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var hit *Interaction
+	for _, it := range c.interactions {
+		if !c.match(&cr, &it.Request) {
+			continue
+		}
+		hit = it
+		if !c.used[it] {
+			break
+		}
+	}
+	if hit == nil {
+		return nil, fmt.Errorf("%w: %v %v", ErrCassetteMiss, cr.Method, cr.URL)
+	}
+	c.used[hit] = true
+	return hit.Response.response(req)
+}
+
+func (c *Cassette) record(req *http.Request, cr CassetteRequest) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body) // 保存原始的body，回放时同样按Content-Encoding解压
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	it := &Interaction{Request: cr, Response: CassetteResponse{
+		StatusCode: resp.StatusCode,
+		Header:     c.redactHeader(resp.Header),
+	}}
+	it.Response.Body, it.Response.Base64 = encodeBody(body)
+	c.mu.Lock()
+	c.interactions = append(c.interactions, it)
+	c.mu.Unlock()
+	return resp, nil
+}
+
+// Close 录制时写入文件
+func (c *Cassette) Close() error {
+	if !c.recording {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	raw, err := cassetteJSON.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.Path, raw, 0644)
+}
+
+// 脱敏后的请求，录制和回放时都用它匹配
+func (c *Cassette) request(req *http.Request, body []byte) (cr CassetteRequest) {
+	cr.Method = req.Method
+	cr.URL = c.redactURL(req.URL)
+	cr.Header = c.redactHeader(req.Header)
+	cr.Body, cr.Base64 = encodeBody(c.redactBody(req.Header.Get("Content-Type"), body))
+	return
+}
+
+func (c *Cassette) match(req, rec *CassetteRequest) bool {
+	if c.Match != nil {
+		return c.Match(req, rec)
+	}
+	return req.Method == rec.Method && req.URL == rec.URL && sameBody(req, rec)
+}
+
+// json的body按内容比较，忽略字段顺序和空白
+func sameBody(a, b *CassetteRequest) bool {
+	if a.Body == b.Body {
+		return true
+	}
+	if a.Base64 || b.Base64 {
+		return false
+	}
+	var va, vb any
+	if cassetteJSON.UnmarshalFromString(a.Body, &va) != nil || cassetteJSON.UnmarshalFromString(b.Body, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func (c *Cassette) redactHeader(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	names := c.RedactHeader
+	if len(names) == 0 {
+		names = DefaultRedactHeaders
+	}
+	h = h.Clone()
+	for _, name := range names {
+		if _, ok := h[http.CanonicalHeaderKey(name)]; ok {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+// query排序后输出，脱敏的参数替换掉
+func (c *Cassette) redactURL(u *url.URL) string {
+	q := u.Query()
+	for _, name := range c.RedactField {
+		if q.Has(name) {
+			q.Set(name, Redacted)
+		}
+	}
+	cp := *u
+	cp.RawQuery = q.Encode()
+	return cp.String()
+}
+
+// 只处理json和表单，其他body原样保留
+func (c *Cassette) redactBody(contentType string, body []byte) []byte {
+	if len(c.RedactField) == 0 || len(body) == 0 {
+		return body
+	}
+	var v any
+	if err := cassetteJSON.Unmarshal(body, &v); err == nil {
+		if raw, err := cassetteJSON.Marshal(redactValue(v, c.RedactField)); err == nil {
+			return raw
+		}
+		return body
+	}
+	if !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return body
+	}
+	if form, err := url.ParseQuery(string(body)); err == nil {
+		for _, name := range c.RedactField {
+			if form.Has(name) {
+				form.Set(name, Redacted)
+			}
+		}
+		return []byte(form.Encode())
+	}
+	return body
+}
+
+func redactValue(v any, fields []string) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		for k, item := range vv {
+			if Index(fields, k) >= 0 {
+				vv[k] = Redacted
+			} else {
+				vv[k] = redactValue(item, fields)
+			}
+		}
+	case []any:
+		for i, item := range vv {
+			vv[i] = redactValue(item, fields)
+		}
+	}
+	return v
+}
+
+func (r CassetteResponse) response(req *http.Request) (*http.Response, error) {
+	body := []byte(r.Body)
+	if r.Base64 {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(r.Body); err != nil {
+			return nil, err
+		}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// RoundTripper需要关闭请求的body
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
+
+func encodeBody(body []byte) (string, bool) {
+	if utf8.Valid(body) {
+		return string(body), false
+	}
+	return base64.StdEncoding.EncodeToString(body), true
+}
+
+// SetTransport 替换DoRequest等函数和DefaultClient使用的RoundTripper，返回恢复的函数；只用于测试
+func SetTransport(rt http.RoundTripper) (restore func()) {
+	oldLegacy, oldDefault := http.DefaultClient.Transport, DefaultClient.http.Transport
+	http.DefaultClient.Transport, DefaultClient.http.Transport = rt, rt
+	return func() {
+		http.DefaultClient.Transport, DefaultClient.http.Transport = oldLegacy, oldDefault
+	}
+}
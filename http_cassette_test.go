@@ -0,0 +1,85 @@
+package lib
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCassette(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Set-Cookie", "session=secret")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte(`{"n":` + string(rune('0'+n)) + `}`))
+	}))
+	path := filepath.Join(t.TempDir(), "cassette", "api.json")
+
+	// 录制
+	c, err := NewCassette(path, CassetteAuto)
+	if err != nil || !c.Recording() {
+		t.Fatalf("new = %v, %v", c, err)
+	}
+	c.RedactField = []string{"password", "token"}
+	restore := SetTransport(c)
+	send := func(method, uri, body string) (rb []byte, err error) {
+		req, _ := http.NewRequest(method, srv.URL+uri, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set("Content-Type", "application/json")
+		return DoRequest(req)
+	}
+	for i := 0; i < 2; i++ {
+		if rb, err := send(http.MethodPost, "/login?b=2&a=1&token=abc", `{"user":"u","password":"p1"}`); err != nil || len(rb) == 0 {
+			t.Fatalf("record = %s, %v", rb, err)
+		}
+	}
+	if _, err = send(http.MethodGet, "/missing", ""); !IsHTTPStatus(err, http.StatusNotFound) {
+		t.Fatalf("record 404 = %v", err)
+	}
+	restore()
+	if err = c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := os.ReadFile(path)
+	for _, secret := range []string{"p1", "abc", "Bearer secret", "session=secret"} {
+		if strings.Contains(string(raw), secret) {
+			t.Errorf("%q not redacted: %s", secret, raw)
+		}
+	}
+
+	// 回放，服务已经关闭；json字段顺序、query顺序和脱敏的值不影响匹配
+	srv.Close()
+	c, err = NewCassette(path, CassetteAuto)
+	if err != nil || c.Recording() {
+		t.Fatalf("load = %v, %v", c, err)
+	}
+	c.RedactField = []string{"password", "token"}
+	defer SetTransport(c)()
+	for _, want := range []string{`{"n":1}`, `{"n":2}`, `{"n":2}`} {
+		rb, err := send(http.MethodPost, "/login?a=1&token=other&b=2", `{"password":"p2", "user":"u"}`)
+		if err != nil || string(rb) != want {
+			t.Errorf("replay = %s, %v, want %v", rb, err, want)
+		}
+	}
+	if _, err = send(http.MethodGet, "/missing", ""); !IsHTTPStatus(err, http.StatusNotFound) {
+		t.Errorf("replay 404 = %v", err)
+	}
+	if _, err = send(http.MethodPost, "/login", `{"user":"other"}`); !errors.Is(err, ErrCassetteMiss) {
+		t.Errorf("miss = %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %v", calls)
+	}
+
+	if _, err = NewCassette(filepath.Join(t.TempDir(), "none.json"), CassetteReplay); err == nil {
+		t.Error("replay without file accepted")
+	}
+}
@@ -0,0 +1,276 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen 熔断期间直接返回，不发请求
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// ClientConfig http客户端配置，零值表示不限制、不重试、不熔断
+type ClientConfig struct {
+	Timeout      time.Duration // 单次请求的超时时间，包括读取body
+	Retry        int           // 最多重试次数，只重试幂等请求或者带Idempotency-Key的请求
+	RetryWait    time.Duration // 首次重试的等待时间，之后翻倍，默认200ms
+	MaxRetryWait time.Duration // 最长等待时间，Retry-After 也不超过它，默认10s
+	Breaker      int           // 同一个host连续失败多少次后熔断，0表示不熔断
+	BreakerWait  time.Duration // 熔断多久后放一个请求试探，默认30s
+	StatusOK     int           // 大于等于这个状态码的算失败，默认使用全局的StatusOK
+	MaxBodySize  int64         // 解压后body的最大长度，超过返回ErrBodyTooLarge，0表示不限制
+	Transport    http.RoundTripper
+}
+
+// Client 带重试、熔断和钩子的http客户端，通过NewClient创建
+type Client struct {
+	cfg        ClientConfig
+	http       *http.Client
+	onRequest  []func(req *http.Request)
+	onResponse []func(req *http.Request, resp *http.Response, err error)
+	breakers   sync.Map // host -> *breaker
+	legacy     bool     // 旧函数使用，错误信息和原来一样
+}
+
+func NewClient(cfg ClientConfig) *Client {
+	if cfg.RetryWait <= 0 {
+		cfg.RetryWait = 200 * time.Millisecond
+	}
+	if cfg.MaxRetryWait <= 0 {
+		cfg.MaxRetryWait = 10 * time.Second
+	}
+	if cfg.BreakerWait <= 0 {
+		cfg.BreakerWait = 30 * time.Second
+	}
+	return &Client{
+		cfg:  cfg,
+		http: &http.Client{Timeout: cfg.Timeout, Transport: cfg.Transport},
+	}
+}
+
+// DefaultClient 带超时和重试的客户端，新代码直接使用 DefaultClient.DoRequest
+var DefaultClient = NewClient(ClientConfig{Timeout: 60 * time.Second, Retry: 2})
+
+// DoRequest等旧函数使用，和原来一样走http.DefaultClient
+var legacyClient = &Client{http: http.DefaultClient, legacy: true}
+
+// OnRequest 每次发送前调用，包括重试，可以用来加签名、header
+func (c *Client) OnRequest(fn func(req *http.Request)) *Client {
+	c.onRequest = append(c.onRequest, fn)
+	return c
+}
+
+// OnResponse 每次收到响应或者失败后调用，包括重试，可以用来打日志、统计
+func (c *Client) OnResponse(fn func(req *http.Request, resp *http.Response, err error)) *Client {
+	c.onResponse = append(c.onResponse, fn)
+	return c
+}
+
+func (c *Client) statusOK() int {
+	if c.cfg.StatusOK > 0 {
+		return c.cfg.StatusOK
+	}
+	return StatusOK
+}
+
+// Do 发送请求，按配置重试和熔断；返回的resp需要调用方关闭
+func (c *Client) Do(req *http.Request) (resp *http.Response, err error) {
+	if req == nil {
+		return nil, errors.New("no request")
+	}
+	b := c.breaker(req.URL.Host)
+	retryable := canRetry(req)
+	for attempt := 0; ; attempt++ {
+		if !b.allow() {
+			return nil, ErrCircuitOpen
+		}
+		if attempt > 0 && req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+		for _, fn := range c.onRequest {
+			fn(req)
+		}
+		resp, err = c.http.Do(req)
+		for _, fn := range c.onResponse {
+			fn(req, resp, err)
+		}
+		b.done(err == nil && resp.StatusCode < 500)
+
+		if !retryable || attempt >= c.cfg.Retry || !shouldRetry(req.Context(), resp, err) {
+			return resp, err
+		}
+		wait := c.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// 幂等的方法才重试，有body的需要可以重新读取
+func canRetry(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace:
+		return true
+	}
+	return len(req.Header.Get("Idempotency-Key")) > 0
+}
+
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// 优先使用Retry-After，否则指数退避加随机抖动
+func (c *Client) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return Min(wait, c.cfg.MaxRetryWait)
+		}
+	}
+	wait := c.cfg.RetryWait << attempt
+	wait += time.Duration(rand.Int63n(int64(wait)/2 + 1))
+	return Min(wait, c.cfg.MaxRetryWait)
+}
+
+// Retry-After 可以是秒数或者http时间
+func retryAfter(v string) (time.Duration, bool) {
+	if len(v) == 0 {
+		return 0, false
+	}
+	if sec, err := strconv.Atoi(v); err == nil && sec >= 0 {
+		return time.Duration(sec) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return Max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// breaker 连续失败达到阈值后打开，等待一段时间后放一个请求试探，成功后关闭
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	wait      time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (c *Client) breaker(host string) *breaker {
+	if c.cfg.Breaker <= 0 {
+		return nil
+	}
+	b, _ := c.breakers.LoadOrStore(host, &breaker{threshold: c.cfg.Breaker, wait: c.cfg.BreakerWait})
+	return b.(*breaker)
+}
+
+func (b *breaker) allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *breaker) done(ok bool) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if ok {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.wait)
+	}
+}
+
+// DoRequest 发送请求并读取body，状态码不小于StatusOK时返回HTTPError
+func (c *Client) DoRequest(req *http.Request) (rb []byte, err error) {
+	resp, err := c.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= c.statusOK() {
+		err = c.httpError(req, resp, textStatus)
+		return
+	}
+	return c.read(resp)
+}
+
+// DoRequestJson 发送请求并解析json
+func (c *Client) DoRequestJson(req *http.Request, v interface{}) (err error) {
+	rb, err := c.DoRequest(req)
+	if err != nil {
+		return
+	}
+	if v == nil {
+		return
+	}
+	err = json.Unmarshal(rb, v)
+	if err != nil {
+		err = fmt.Errorf("parse json err=%s, body=%s", err.Error(), string(rb))
+	}
+	return
+}
+
+// DoRequestHasErrMsg 状态码不小于300时返回HTTPError，错误信息包含body
+func (c *Client) DoRequestHasErrMsg(req *http.Request) (rb []byte, err error) {
+	resp, err := c.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		err = c.httpError(req, resp, textBody)
+		return
+	}
+	return c.read(resp)
+}
+
+// 旧函数的错误信息和原来一样，errors.As仍然可以取出HTTPError
+func (c *Client) httpError(req *http.Request, resp *http.Response, legacy errorText) *HTTPError {
+	if c.legacy {
+		return newHTTPErrorText(req, resp, legacy)
+	}
+	return newHTTPError(req, resp)
+}
@@ -0,0 +1,82 @@
+package lib
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_retry(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	var hooks int32
+	c := NewClient(ClientConfig{Timeout: time.Second, Retry: 3, RetryWait: time.Millisecond}).
+		OnResponse(func(req *http.Request, resp *http.Response, err error) { atomic.AddInt32(&hooks, 1) })
+	var v struct{ Ok bool }
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err := c.DoRequestJson(req, &v); err != nil || !v.Ok {
+		t.Fatalf("v = %v, err = %v", v, err)
+	}
+	if calls != 3 || hooks != 3 {
+		t.Errorf("calls = %v, hooks = %v", calls, hooks)
+	}
+
+	// POST 不重试
+	atomic.StoreInt32(&calls, 0)
+	req, _ = http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("{}"))
+	if _, err := c.DoRequest(req); err == nil || calls != 1 {
+		t.Errorf("calls = %v, err = %v", calls, err)
+	}
+}
+
+func TestDoRequest_legacy(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	// 旧函数不重试，新代码通过DefaultClient开启
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if _, err := DoRequest(req); err == nil || calls != 1 {
+		t.Errorf("calls = %v, err = %v", calls, err)
+	}
+	if legacyClient.http != http.DefaultClient || legacyClient.cfg.Retry != 0 {
+		t.Errorf("legacy client = %+v", legacyClient.cfg)
+	}
+}
+
+func TestClient_breaker(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewClient(ClientConfig{Breaker: 2, BreakerWait: time.Hour})
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+		if _, err := c.DoRequest(req); err == nil {
+			t.Fatal("want error")
+		}
+	}
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if _, err := c.DoRequest(req); !errors.Is(err, ErrCircuitOpen) || calls != 2 {
+		t.Errorf("calls = %v, err = %v", calls, err)
+	}
+}
@@ -0,0 +1,105 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+)
+
+// MaxErrorBody HTTPError 最多保留的body长度
+var MaxErrorBody = 4096
+
+// HTTPError 状态码不符合预期时返回，可以用errors.As取出来判断状态码或者解析body
+type HTTPError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte // 超过MaxErrorBody的部分会截断
+	Truncated  bool
+	text       errorText
+}
+
+// Error() 的内容，旧函数保持原来的错误信息
+type errorText int8
+
+const (
+	textStatusBody errorText = iota // 状态，有body时附上body
+	textStatus                      // 旧版DoRequest，只有状态
+	textBody                        // 旧版DoRequestHasErrMsg，只有完整的body
+)
+
+func newHTTPError(req *http.Request, resp *http.Response) *HTTPError {
+	return newHTTPErrorText(req, resp, textStatusBody)
+}
+
+func newHTTPErrorText(req *http.Request, resp *http.Response, text errorText) *HTTPError {
+	e := &HTTPError{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		text:       text,
+	}
+	limit := int64(MaxErrorBody)
+	if text == textBody {
+		limit = math.MaxInt64 - 1 // 原来返回完整的body
+	}
+	body, err := readBodyLimit(resp, limit+1)
+	if err == nil || len(body) > 0 {
+		e.Body, e.Truncated = body, int64(len(body)) > limit
+		if e.Truncated {
+			e.Body = e.Body[:limit]
+		}
+	}
+	return e
+}
+
+func (e *HTTPError) Error() string {
+	switch {
+	case e.text == textStatus:
+		return e.Status
+	case e.text == textBody:
+		return string(e.Body)
+	case len(e.Body) == 0:
+		return e.Status
+	}
+	return fmt.Sprintf("%s: %s", e.Status, e.Body)
+}
+
+// Decode 把json格式的错误body解析到v
+func (e *HTTPError) Decode(v any) error {
+	if e.Truncated {
+		return fmt.Errorf("error body truncated at %v bytes", MaxErrorBody)
+	}
+	return json.Unmarshal(e.Body, v)
+}
+
+// DecodeHTTPError err是HTTPError时把body解析到v，例如 {"code": 1001, "message": "..."}
+func DecodeHTTPError(err error, v any) error {
+	var e *HTTPError
+	if !errors.As(err, &e) {
+		return fmt.Errorf("not http error: %w", err)
+	}
+	return e.Decode(v)
+}
+
+// IsHTTPStatus err是否是指定状态码的HTTPError
+func IsHTTPStatus(err error, code int) bool {
+	var e *HTTPError
+	return errors.As(err, &e) && e.StatusCode == code
+}
+
+// 最多读取limit字节，解压后计算
+func readBodyLimit(resp *http.Response, limit int64) ([]byte, error) {
+	r, err := bodyReader(resp)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(io.LimitReader(r, limit))
+}
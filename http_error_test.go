@@ -0,0 +1,54 @@
+package lib
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":1001,"message":"not found"}`))
+	}))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/user/1", nil)
+	_, err := DoRequestHasErrMsg(req)
+	var e *HTTPError
+	if !errors.As(err, &e) {
+		t.Fatalf("err = %#v", err)
+	}
+	if e.StatusCode != http.StatusNotFound || e.Header.Get("X-Request-Id") != "abc" || e.URL != srv.URL+"/user/1" {
+		t.Errorf("err = %+v", e)
+	}
+	if !IsHTTPStatus(err, http.StatusNotFound) {
+		t.Error("IsHTTPStatus")
+	}
+
+	var body struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := DecodeHTTPError(err, &body); err != nil || body.Code != 1001 {
+		t.Errorf("body = %+v, err = %v", body, err)
+	}
+	if err := DecodeHTTPError(errors.New("x"), &body); err == nil {
+		t.Error("want error")
+	}
+
+	// 旧函数的错误信息和原来一样
+	if got := err.Error(); got != `{"code":1001,"message":"not found"}` {
+		t.Errorf("DoRequestHasErrMsg err = %v", got)
+	}
+	req, _ = http.NewRequest(http.MethodGet, srv.URL+"/user/1", nil)
+	if _, err = DoRequest(req); err == nil || err.Error() != "404 Not Found" || !IsHTTPStatus(err, http.StatusNotFound) {
+		t.Errorf("DoRequest err = %v", err)
+	}
+	req, _ = http.NewRequest(http.MethodGet, srv.URL+"/user/1", nil)
+	if _, err = NewClient(ClientConfig{}).DoRequestHasErrMsg(req); err == nil || err.Error() != `404 Not Found: {"code":1001,"message":"not found"}` {
+		t.Errorf("Client.DoRequestHasErrMsg err = %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrRedirectLoop      = errors.New("redirect loop")
+	ErrTooManyRedirects  = errors.New("too many redirects")
+	metaRefreshRegexp    = regexp.MustCompile(`(?is)<meta[^>]+http-equiv\s*=\s*["']?refresh["']?[^>]*content\s*=\s*["']\s*\d*\s*;?\s*url\s*=\s*['"]?([^"'>\s]+)`)
+	metaRefreshRevRegexp = regexp.MustCompile(`(?is)<meta[^>]+content\s*=\s*["']\s*\d*\s*;?\s*url\s*=\s*['"]?([^"'>\s]+)[^>]*http-equiv\s*=\s*["']?refresh`)
+	jsLocationRegexp     = regexp.MustCompile(`(?i)(?:window\.|document\.|top\.|self\.)?location(?:\.href)?\s*=\s*["']([^"']+)["']|location\.(?:replace|assign)\(\s*["']([^"']+)["']\s*\)`)
+)
+
+// 跳转方式
+const (
+	RedirectLocation = "location" // 3xx + Location
+	RedirectMeta     = "meta"     // <meta http-equiv="refresh">
+	RedirectJS       = "js"       // window.location.href=，例如server.Redirect2
+)
+
+// RedirectHop 跳转链路中的一次请求，Next为空表示最终地址
+type RedirectHop struct {
+	URL        string `json:"url"`
+	Method     string `json:"method"`
+	StatusCode int    `json:"status_code"`
+	Next       string `json:"next,omitempty"`
+	Via        string `json:"via,omitempty"` // 怎么得到Next的
+}
+
+// RedirectResolver 展开短链接，零值使用默认配置
+type RedirectResolver struct {
+	MaxHops      int           // 最多跳转次数，默认10
+	Timeout      time.Duration // 整体超时，默认10s
+	MaxBody      int64         // 查找meta和js跳转时最多读取的body，默认64KB
+	UserAgent    string
+	Transport    http.RoundTripper
+	LocationOnly bool // 只跟随3xx的Location，不解析页面里的meta和js跳转
+}
+
+// ResolveRedirect 使用默认配置展开跳转链路
+func ResolveRedirect(raw string) ([]RedirectHop, error) {
+	return RedirectResolver{}.Resolve(context.Background(), raw)
+}
+
+// Resolve 依次请求每一跳，优先HEAD，不支持或者是html页面时用GET；出错时也返回已经走过的链路
+func (r RedirectResolver) Resolve(ctx context.Context, raw string) (chain []RedirectHop, err error) {
+	r = r.withDefault()
+	ctx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+	client := r.client()
+
+	visited := make(map[string]bool)
+	for current := raw; ; {
+		u, err := url.Parse(current)
+		if err != nil {
+			return chain, err
+		}
+		if visited[u.String()] {
+			return chain, fmt.Errorf("%w: %v", ErrRedirectLoop, u)
+		}
+		visited[u.String()] = true
+
+		hop, err := r.hop(ctx, client, u)
+		if err != nil {
+			return chain, err
+		}
+		chain = append(chain, hop)
+		if len(hop.Next) == 0 {
+			return chain, nil
+		}
+		if len(chain) > r.MaxHops {
+			return chain, ErrTooManyRedirects
+		}
+		current = hop.Next
+	}
+}
+
+// Hop 只请求一次，返回下一跳的地址，不会请求下一跳
+func (r RedirectResolver) Hop(ctx context.Context, raw string) (RedirectHop, error) {
+	r = r.withDefault()
+	ctx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+	u, err := url.Parse(raw)
+	if err != nil {
+		return RedirectHop{}, err
+	}
+	return r.hop(ctx, r.client(), u)
+}
+
+func (r RedirectResolver) withDefault() RedirectResolver {
+	if r.MaxHops <= 0 {
+		r.MaxHops = 10
+	}
+	if r.Timeout <= 0 {
+		r.Timeout = 10 * time.Second
+	}
+	if r.MaxBody <= 0 {
+		r.MaxBody = 64 << 10
+	}
+	return r
+}
+
+// 不自动跳转，每一跳自己处理
+func (r RedirectResolver) client() *http.Client {
+	return &http.Client{
+		Transport: r.Transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func (r RedirectResolver) hop(ctx context.Context, client *http.Client, u *url.URL) (hop RedirectHop, err error) {
+	hop = RedirectHop{URL: u.String(), Method: http.MethodHead}
+	resp, err := r.do(ctx, client, http.MethodHead, u)
+	if err == nil {
+		resp.Body.Close()
+		hop.StatusCode = resp.StatusCode
+		if next := location(u, resp); len(next) > 0 {
+			hop.Next, hop.Via = next, RedirectLocation
+			return
+		}
+		if resp.StatusCode < 300 && !isHTML(resp) && len(resp.Header.Get("Content-Type")) > 0 {
+			return // 不是页面，不会再跳转了
+		}
+	}
+
+	// HEAD 失败、不支持或者是页面，用GET再请求一次
+	hop.Method = http.MethodGet
+	if resp, err = r.do(ctx, client, http.MethodGet, u); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	hop.StatusCode = resp.StatusCode
+	if next := location(u, resp); len(next) > 0 {
+		hop.Next, hop.Via = next, RedirectLocation
+		return
+	}
+	if resp.StatusCode >= 300 || !isHTML(resp) || r.LocationOnly {
+		return
+	}
+	body, err := readBodyLimit(resp, r.MaxBody)
+	if err != nil && len(body) == 0 {
+		return hop, nil // 读不到页面就当作最终地址
+	}
+	hop.Next, hop.Via = findPageRedirect(u, string(body))
+	return hop, nil
+}
+
+func (r RedirectResolver) do(ctx context.Context, client *http.Client, method string, u *url.URL) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.UserAgent) > 0 {
+		req.Header.Set("User-Agent", r.UserAgent)
+	}
+	return client.Do(req)
+}
+
+func location(base *url.URL, resp *http.Response) string {
+	if resp.StatusCode < 300 || resp.StatusCode >= 400 {
+		return ""
+	}
+	loc, err := resp.Location()
+	if err != nil {
+		return ""
+	}
+	return base.ResolveReference(loc).String()
+}
+
+func isHTML(resp *http.Response) bool {
+	ct := strings.ToLower(resp.Header.Get("Content-Type"))
+	return len(ct) == 0 || strings.Contains(ct, "html")
+}
+
+// 页面里的meta refresh或者js跳转
+func findPageRedirect(base *url.URL, body string) (next, via string) {
+	for _, re := range []*regexp.Regexp{metaRefreshRegexp, metaRefreshRevRegexp} {
+		if m := re.FindStringSubmatch(body); m != nil {
+			return resolveRef(base, m[1]), RedirectMeta
+		}
+	}
+	if m := jsLocationRegexp.FindStringSubmatch(body); m != nil {
+		ref := m[1]
+		if len(ref) == 0 {
+			ref = m[2]
+		}
+		return resolveRef(base, ref), RedirectJS
+	}
+	return "", ""
+}
+
+func resolveRef(base *url.URL, ref string) string {
+	u, err := url.Parse(html.UnescapeString(strings.TrimSpace(ref)))
+	if err != nil {
+		return ""
+	}
+	return base.ResolveReference(u).String()
+}
@@ -0,0 +1,73 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveRedirect(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/a", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/b", http.StatusFound)
+	})
+	mux.HandleFunc("/b", func(w http.ResponseWriter, r *http.Request) { // 和server.Redirect2一样的页面
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<html><script>window.location.href="/c?x=1&amp;y=2";</script></html>`))
+	})
+	mux.HandleFunc("/c", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<meta http-equiv="refresh" content="0; url=/d">`))
+	})
+	mux.HandleFunc("/d", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/x", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/y", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/y", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/x", http.StatusFound)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	chain, err := ResolveRedirect(srv.URL + "/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []RedirectHop{
+		{URL: srv.URL + "/a", Method: http.MethodHead, StatusCode: http.StatusFound, Next: srv.URL + "/b", Via: RedirectLocation},
+		{URL: srv.URL + "/b", Method: http.MethodGet, StatusCode: http.StatusOK, Next: srv.URL + "/c?x=1&y=2", Via: RedirectJS},
+		{URL: srv.URL + "/c?x=1&y=2", Method: http.MethodGet, StatusCode: http.StatusOK, Next: srv.URL + "/d", Via: RedirectMeta},
+		{URL: srv.URL + "/d", Method: http.MethodGet, StatusCode: http.StatusOK},
+	}
+	if len(chain) != len(want) {
+		t.Fatalf("chain = %+v", chain)
+	}
+	for i := range want {
+		if chain[i] != want[i] {
+			t.Errorf("hop %v = %+v, want %+v", i, chain[i], want[i])
+		}
+	}
+
+	if _, err = ResolveRedirect(srv.URL + "/x"); !errors.Is(err, ErrRedirectLoop) {
+		t.Errorf("err = %v", err)
+	}
+	if _, err = (RedirectResolver{MaxHops: 1}).Resolve(context.Background(), srv.URL+"/a"); !errors.Is(err, ErrTooManyRedirects) {
+		t.Errorf("err = %v", err)
+	}
+	if got := GetRedirectURL(srv.URL + "/a"); got != srv.URL+"/b" {
+		t.Errorf("GetRedirectURL = %v", got)
+	}
+	// 页面跳转不算
+	if got := GetRedirectURL(srv.URL + "/b"); got != "" {
+		t.Errorf("GetRedirectURL js = %v", got)
+	}
+}
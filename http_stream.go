@@ -0,0 +1,193 @@
+package lib
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// ErrBodyTooLarge body超过限制
+var ErrBodyTooLarge = errors.New("response body too large")
+
+// 超过limit时返回ErrBodyTooLarge，而不是静默截断
+type limitReader struct {
+	io.ReadCloser
+	remain int64
+}
+
+func (r *limitReader) Read(p []byte) (n int, err error) {
+	if r.remain < 0 {
+		return 0, ErrBodyTooLarge
+	}
+	if int64(len(p)) > r.remain+1 {
+		p = p[:r.remain+1]
+	}
+	n, err = r.ReadCloser.Read(p)
+	r.remain -= int64(n)
+	if r.remain < 0 {
+		return n + int(r.remain), ErrBodyTooLarge
+	}
+	return
+}
+
+func limitBody(r io.ReadCloser, limit int64) io.ReadCloser {
+	if limit <= 0 {
+		return r
+	}
+	return &limitReader{ReadCloser: r, remain: limit}
+}
+
+// 按MaxBodySize限制读取解压后的body
+func (c *Client) read(resp *http.Response) ([]byte, error) {
+	r, err := bodyReader(resp)
+	if err != nil {
+		return nil, err
+	}
+	r = limitBody(r, c.cfg.MaxBodySize)
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// Stream 发送请求，返回解压后的body，最多读取limit字节(0表示使用MaxBodySize)；调用方需要关闭
+func (c *Client) Stream(req *http.Request, limit int64) (io.ReadCloser, *http.Response, error) {
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode >= c.statusOK() {
+		defer resp.Body.Close()
+		return nil, resp, newHTTPError(req, resp)
+	}
+	r, err := bodyReader(resp)
+	if err != nil {
+		resp.Body.Close()
+		return nil, resp, err
+	}
+	if limit <= 0 {
+		limit = c.cfg.MaxBodySize
+	}
+	return chainCloser(limitBody(r, limit), r, resp.Body), resp, nil
+}
+
+// DoRequestStream 使用DefaultClient的Stream
+func DoRequestStream(req *http.Request, limit int64) (io.ReadCloser, error) {
+	r, _, err := DefaultClient.Stream(req, limit)
+	return r, err
+}
+
+// 下载不能有整体超时，由调用方通过req的context控制
+var downloadClient = NewClient(ClientConfig{Retry: 2})
+
+// DownloadTo 下载url到path，见Client.DownloadTo
+func DownloadTo(url, path, checksum string) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	return downloadClient.DownloadTo(req, path, checksum)
+}
+
+// DownloadTo 先下载到path.part，中断后再次调用会通过Range继续下载；
+// checksum 格式 sha256:hex、sha1:hex、md5:hex，为空不校验，校验失败会删除文件重新下载
+func (c *Client) DownloadTo(req *http.Request, path, checksum string) error {
+	h, want, err := parseChecksum(checksum)
+	if err != nil {
+		return err
+	}
+	part := path + ".part"
+	f, err := os.OpenFile(part, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", "identity") // 压缩后的Range没有意义
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusPartialContent && !strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)) {
+		return fmt.Errorf("unexpected content range %q", resp.Header.Get("Content-Range"))
+	}
+	switch {
+	case resp.StatusCode == http.StatusPartialContent:
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0: // 已经下载完了
+	case resp.StatusCode < c.statusOK(): // 不支持Range，从头下载
+		if err = f.Truncate(0); err != nil {
+			return err
+		}
+		if _, err = f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	default:
+		return newHTTPError(req, resp)
+	}
+	if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		if _, err = io.Copy(f, limitBody(resp.Body, c.cfg.MaxBodySize)); err != nil {
+			return err
+		}
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if h != nil {
+		if err = verifyChecksum(part, h, want); err != nil {
+			_ = os.Remove(part)
+			return err
+		}
+	}
+	return os.Rename(part, path)
+}
+
+func parseChecksum(checksum string) (hash.Hash, string, error) {
+	if len(checksum) == 0 {
+		return nil, "", nil
+	}
+	algo, sum, ok := strings.Cut(checksum, ":")
+	if !ok {
+		return nil, "", fmt.Errorf("invalid checksum %q", checksum)
+	}
+	switch strings.ToLower(algo) {
+	case "sha256":
+		return sha256.New(), strings.ToLower(sum), nil
+	case "sha1":
+		return sha1.New(), strings.ToLower(sum), nil
+	case "md5":
+		return md5.New(), strings.ToLower(sum), nil
+	}
+	return nil, "", fmt.Errorf("unsupported checksum %q", algo)
+}
+
+func verifyChecksum(path string, h hash.Hash, want string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = io.Copy(h, f); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != want {
+		return fmt.Errorf("checksum mismatch: got %v, want %v", got, want)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package lib
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestBodyReader(t *testing.T) {
+	plain := strings.Repeat("hello world ", 100)
+	encode := map[string]func(w io.Writer) io.WriteCloser{
+		"gzip":    func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) },
+		"deflate": func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) },
+		"br":      func(w io.Writer) io.WriteCloser { return brotli.NewWriter(w) },
+		"zstd": func(w io.Writer) io.WriteCloser {
+			zw, _ := zstd.NewWriter(w)
+			return zw
+		},
+	}
+	for name, fn := range encode {
+		buf := new(bytes.Buffer)
+		w := fn(buf)
+		_, _ = w.Write([]byte(plain))
+		_ = w.Close()
+
+		resp := &http.Response{Header: http.Header{"Content-Encoding": {name}}, Body: io.NopCloser(buf)}
+		if b, err := readBody(resp); err != nil || string(b) != plain {
+			t.Errorf("%v: %v", name, err)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Content-Encoding": {"gzip"}}, Body: io.NopCloser(strings.NewReader("not gzip"))}
+	if _, err := readBody(resp); err == nil {
+		t.Error("invalid gzip should fail")
+	}
+}
+
+func TestClient_Stream(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(bytes.Repeat([]byte("a"), 100))
+	}))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	r, err := DoRequestStream(req, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if b, err := io.ReadAll(r); !errors.Is(err, ErrBodyTooLarge) || len(b) != 10 {
+		t.Errorf("len = %v, err = %v", len(b), err)
+	}
+}
+
+func TestDownloadTo(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "bytes=4000-" {
+			t.Errorf("range = %q", r.Header.Get("Range"))
+		}
+		http.ServeContent(w, r, "data.bin", time.Time{}, bytes.NewReader(content))
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "data.bin")
+	if err := os.WriteFile(path+".part", content[:4000], 0644); err != nil { // 上次下载到一半
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(content)
+	if err := DownloadTo(srv.URL, path, "sha256:"+hex.EncodeToString(sum[:])); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(path); !bytes.Equal(b, content) {
+		t.Error("content mismatch")
+	}
+
+	_ = os.WriteFile(path+".part", content[:4000], 0644)
+	if err := DownloadTo(srv.URL, path, "md5:00"); err == nil {
+		t.Error("checksum should fail")
+	}
+	if _, err := os.Stat(path + ".part"); !os.IsNotExist(err) {
+		t.Error("part file should be removed")
+	}
+}
@@ -0,0 +1,231 @@
+package lib
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrNoPrimaryKey      = errors.New("keyring: no primary key")
+	ErrKeyNotFound       = errors.New("keyring: key not found")
+	ErrInvalidCiphertext = errors.New("keyring: invalid ciphertext")
+)
+
+// 信封格式版本，之后修改格式时递增
+const envelopeV1 byte = 1
+
+// Keyring AES-GCM密钥环；加密使用主密钥，解密按信封里的密钥ID查找，轮换后旧数据仍然可以解密
+//
+// 信封格式 base64url(版本 | ID长度 | ID | nonce(12) | 密文+tag)，版本和ID作为附加数据参与认证
+type Keyring struct {
+	mu      sync.RWMutex
+	keys    map[string]cipher.AEAD
+	macs    map[string][]byte // 确定性加密计算nonce用，由密钥派生
+	primary string
+}
+
+func NewKeyring() *Keyring {
+	return &Keyring{keys: make(map[string]cipher.AEAD), macs: make(map[string][]byte)}
+}
+
+// LoadKeyring 从配置加载，格式 id:base64密钥,id:base64密钥，第一个是主密钥
+func LoadKeyring(spec string) (*Keyring, error) {
+	k := NewKeyring()
+	for i, item := range strings.Split(spec, ",") {
+		id, raw, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok {
+			return nil, fmt.Errorf("keyring: invalid key %q", item)
+		}
+		key, err := base64.StdEncoding.DecodeString(raw)
+		if err != nil {
+			if key, err = base64.RawURLEncoding.DecodeString(raw); err != nil {
+				return nil, fmt.Errorf("keyring: key %v: %w", id, err)
+			}
+		}
+		if err = k.Add(id, key); err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			_ = k.SetPrimary(id)
+		}
+	}
+	return k, nil
+}
+
+// Add 添加解密用的密钥，key长度16、24或32
+func (k *Keyring) Add(id string, key []byte) error {
+	if len(id) == 0 || len(id) > 255 {
+		return fmt.Errorf("keyring: invalid key id %q", id)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("keyring: key %v: %w", id, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("keyring deterministic nonce"))
+	k.mu.Lock()
+	k.keys[id] = aead
+	k.macs[id] = mac.Sum(nil)
+	k.mu.Unlock()
+	return nil
+}
+
+// SetPrimary 设置加密用的密钥
+func (k *Keyring) SetPrimary(id string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if _, ok := k.keys[id]; !ok {
+		return ErrKeyNotFound
+	}
+	k.primary = id
+	return nil
+}
+
+// Rotate 添加新密钥并作为主密钥，旧密钥继续用于解密
+func (k *Keyring) Rotate(id string, key []byte) error {
+	if err := k.Add(id, key); err != nil {
+		return err
+	}
+	return k.SetPrimary(id)
+}
+
+// Remove 删除密钥，用它加密的数据将无法解密；不能删除主密钥
+func (k *Keyring) Remove(id string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if id == k.primary {
+		return fmt.Errorf("keyring: cannot remove primary key %v", id)
+	}
+	delete(k.keys, id)
+	delete(k.macs, id)
+	return nil
+}
+
+// Primary 主密钥ID
+func (k *Keyring) Primary() string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.primary
+}
+
+func (k *Keyring) key(id string) (cipher.AEAD, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if len(id) == 0 {
+		return nil, ErrNoPrimaryKey
+	}
+	aead, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrKeyNotFound, id)
+	}
+	return aead, nil
+}
+
+func envelopeHeader(id string) []byte {
+	return append([]byte{envelopeV1, byte(len(id))}, id...)
+}
+
+// 版本和ID也参与认证，防止被替换
+func additional(header, aad []byte) []byte {
+	return append(append(make([]byte, 0, len(header)+len(aad)), header...), aad...)
+}
+
+// Seal 使用主密钥和随机nonce加密，aad需要在解密时原样提供，例如绑定表名和字段名
+func (k *Keyring) Seal(plain, aad []byte) (string, error) {
+	id := k.Primary()
+	aead, err := k.key(id)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return k.seal(id, aead, nonce, plain, aad), nil
+}
+
+// SealDeterministic 相同的明文和aad得到相同的密文，可以用于等值查询和唯一索引；
+// 会暴露哪些值相等，只在需要查询的字段使用。轮换主密钥后密文会变化，旧数据需要重新加密才能查到
+func (k *Keyring) SealDeterministic(plain, aad []byte) (string, error) {
+	id := k.Primary()
+	aead, err := k.key(id)
+	if err != nil {
+		return "", err
+	}
+	k.mu.RLock()
+	mac := hmac.New(sha256.New, k.macs[id])
+	k.mu.RUnlock()
+	// aad带上长度，避免aad和明文的边界被移动
+	mac.Write(binary.BigEndian.AppendUint64(nil, uint64(len(aad))))
+	mac.Write(aad)
+	mac.Write(plain)
+	return k.seal(id, aead, mac.Sum(nil)[:aead.NonceSize()], plain, aad), nil
+}
+
+func (k *Keyring) seal(id string, aead cipher.AEAD, nonce, plain, aad []byte) string {
+	header := envelopeHeader(id)
+	out := append(append([]byte{}, header...), nonce...)
+	out = aead.Seal(out, nonce, plain, additional(header, aad))
+	return base64.RawURLEncoding.EncodeToString(out)
+}
+
+// Open 解密Seal的结果，使用信封里记录的密钥
+func (k *Keyring) Open(envelope string, aad []byte) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(envelope)
+	if err != nil || len(b) < 2 || b[0] != envelopeV1 {
+		return nil, ErrInvalidCiphertext
+	}
+	idLen := int(b[1])
+	if len(b) < 2+idLen {
+		return nil, ErrInvalidCiphertext
+	}
+	header, rest := b[:2+idLen], b[2+idLen:]
+	aead, err := k.key(string(header[2:]))
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrInvalidCiphertext
+	}
+	plain, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], additional(header, aad))
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	return plain, nil
+}
+
+// Encrypt 加密字符串
+func (k *Keyring) Encrypt(plain string) (string, error) {
+	return k.Seal([]byte(plain), nil)
+}
+
+// Decrypt 解密Encrypt的结果
+func (k *Keyring) Decrypt(envelope string) (string, error) {
+	b, err := k.Open(envelope, nil)
+	return string(b), err
+}
+
+// KeyID 信封使用的密钥ID，用于统计还有多少数据没有轮换
+func KeyID(envelope string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(envelope)
+	if err != nil || len(b) < 2 || b[0] != envelopeV1 || len(b) < 2+int(b[1]) {
+		return "", ErrInvalidCiphertext
+	}
+	return string(b[2 : 2+int(b[1])]), nil
+}
+
+// DefaultKeyring 启动时通过LoadKeyring或者Rotate配置
+var DefaultKeyring = NewKeyring()
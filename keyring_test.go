@@ -0,0 +1,88 @@
+package lib
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func TestKeyringRotate(t *testing.T) {
+	k := NewKeyring()
+	if _, err := k.Encrypt("x"); !errors.Is(err, ErrNoPrimaryKey) {
+		t.Fatalf("no primary: %v", err)
+	}
+	if err := k.Rotate("v1", testKey(1)); err != nil {
+		t.Fatal(err)
+	}
+	old, err := k.Encrypt("13800000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = k.Rotate("v2", testKey(2)); err != nil {
+		t.Fatal(err)
+	}
+	cur, _ := k.Encrypt("13800000000")
+	if id, _ := KeyID(old); id != "v1" {
+		t.Errorf("old key id = %v", id)
+	}
+	if id, _ := KeyID(cur); id != "v2" {
+		t.Errorf("current key id = %v", id)
+	}
+	for _, s := range []string{old, cur} {
+		if plain, err := k.Decrypt(s); err != nil || plain != "13800000000" {
+			t.Errorf("decrypt %v = %q, %v", s, plain, err)
+		}
+	}
+	if err = k.Remove("v2"); err == nil {
+		t.Error("removed primary key")
+	}
+	_ = k.Remove("v1")
+	if _, err = k.Decrypt(old); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("removed key: %v", err)
+	}
+}
+
+func TestKeyringTamper(t *testing.T) {
+	k, err := LoadKeyring("a:AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=,b:AgICAgICAgICAgICAgICAg==")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.Primary() != "a" {
+		t.Fatalf("primary = %v", k.Primary())
+	}
+	s, _ := k.Seal([]byte("secret"), []byte("user.phone"))
+	if _, err = k.Open(s, []byte("user.email")); !errors.Is(err, ErrInvalidCiphertext) {
+		t.Errorf("wrong aad: %v", err)
+	}
+	b := []byte(s)
+	b[len(b)-2] ^= 1
+	if _, err = k.Open(string(b), []byte("user.phone")); err == nil {
+		t.Error("tampered ciphertext accepted")
+	}
+	for _, s := range []string{"", "!!", "AQ", "AQFh"} {
+		if _, err = k.Decrypt(s); err == nil {
+			t.Errorf("decrypt %q accepted", s)
+		}
+	}
+}
+
+func TestPKCS7UnPadding(t *testing.T) {
+	for _, c := range []struct {
+		in []byte
+		ok bool
+	}{
+		{append(bytes.Repeat([]byte{'a'}, 14), 2, 2), true},
+		{bytes.Repeat([]byte{16}, 16), true},
+		{append(bytes.Repeat([]byte{'a'}, 15), 0), false},
+		{append(bytes.Repeat([]byte{'a'}, 15), 17), false},
+		{append(bytes.Repeat([]byte{'a'}, 14), 1, 2), false},
+	} {
+		if _, err := PKCS7UnPadding(c.in, 16); (err == nil) != c.ok {
+			t.Errorf("unpadding %v: %v", c.in, err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package lib
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type lruEntry[K comparable, V any] struct {
+	key      K
+	value    V
+	expireAt int64 // 过期时间，单位纳秒，0 不过期
+}
+
+// LRU 并发安全的定长缓存，超出容量淘汰最久未访问的，支持单条过期时间
+type LRU[K comparable, V any] struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	ll    *list.List
+	items map[K]*list.Element
+}
+
+// NewLRU size<=0 不限制数量；ttl<=0 不过期
+func NewLRU[K comparable, V any](size int, ttl time.Duration) *LRU[K, V] {
+	return &LRU[K, V]{
+		size:  size,
+		ttl:   ttl,
+		ll:    list.New(),
+		items: make(map[K]*list.Element),
+	}
+}
+
+func (c *LRU[K, V]) Get(key K) (v V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return
+	}
+	entry := el.Value.(*lruEntry[K, V])
+	if entry.expireAt > 0 && entry.expireAt < time.Now().UnixNano() {
+		c.remove(el)
+		return v, false
+	}
+	c.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+func (c *LRU[K, V]) Set(key K, v V) {
+	c.SetWithTTL(key, v, c.ttl)
+}
+
+// SetWithTTL 单独指定过期时间
+func (c *LRU[K, V]) SetWithTTL(key K, v V, ttl time.Duration) {
+	var expireAt int64
+	if ttl > 0 {
+		expireAt = time.Now().Add(ttl).UnixNano()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*lruEntry[K, V])
+		entry.value = v
+		entry.expireAt = expireAt
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&lruEntry[K, V]{key: key, value: v, expireAt: expireAt})
+	if c.size > 0 && c.ll.Len() > c.size {
+		c.remove(c.ll.Back())
+	}
+}
+
+func (c *LRU[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// Purge 清空缓存
+func (c *LRU[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	c.items = make(map[K]*list.Element)
+}
+
+func (c *LRU[K, V]) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*lruEntry[K, V]).key)
+}
@@ -0,0 +1,35 @@
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	c := NewLRU[string, int](2, 0)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // a 最近访问过，淘汰 b
+	c.Set("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("b should be evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("a = %v, %v", v, ok)
+	}
+	if c.Len() != 2 {
+		t.Errorf("len = %v", c.Len())
+	}
+
+	c.SetWithTTL("d", 4, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.Get("d"); ok {
+		t.Error("d should be expired")
+	}
+
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Error("a should be deleted")
+	}
+}
@@ -0,0 +1,18 @@
+CREATE TABLE `scheduler_run`
+(
+    `id`         bigint(20)   NOT NULL AUTO_INCREMENT,
+    `executor`   varchar(64)  NOT NULL DEFAULT '',
+    `uuid`       varchar(64)  NOT NULL DEFAULT '',
+    `gmt_start`  bigint(20)   NOT NULL DEFAULT '0' COMMENT '毫秒',
+    `gmt_end`    bigint(20)   NOT NULL DEFAULT '0' COMMENT '毫秒',
+    `duration`   bigint(20)   NOT NULL DEFAULT '0' COMMENT '毫秒，聚合记录为平均值',
+    `runs`       int(11)      NOT NULL DEFAULT '1' COMMENT '轮询任务聚合的执行次数',
+    `err`        text,
+    `panic`      text,
+    `processing` text,
+    PRIMARY KEY (`id`),
+    KEY `idx_executor` (`executor`, `gmt_start`),
+    KEY `idx_gmt_start` (`gmt_start`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_unicode_ci;
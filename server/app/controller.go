@@ -187,7 +187,7 @@ func (Controller) login(c *gin.Context) {
 			Uuid:           args.Uuid,
 			Device:         args.Device,
 			DeviceSystem:   args.System,
-			IpAddr:         c.ClientIP(),
+			IpAddr:         lib.EncryptedString(c.ClientIP()),
 			Lang:           c.Request.Header.Get("Accept-Language"),
 			TimezoneOffset: args.Timezone,
 		})
@@ -210,9 +210,9 @@ func (Controller) noticeToken(c *gin.Context) {
 
 	uc := GetUserContext(c)
 	u := Dao.Get(uc.Id)
-	u.FcmToken = args.FcmToken
+	u.FcmToken = lib.EncryptedString(args.FcmToken)
 	u.TimezoneOffset = args.Timezone
-	u.IpAddr = c.ClientIP()
+	u.IpAddr = lib.EncryptedString(c.ClientIP())
 	u.Lang = c.Request.Header.Get("Accept-Language")
 	Dao.Login(u)
 	// 更新用户token
@@ -29,19 +29,19 @@ var HermitRule []nacos.ConfigComputedRule
 var purchaseLogger = lib.GetLogger("purchase-raw")
 
 type UserDO struct {
-	Id           int64  `db:"id"`
-	Uuid         string `db:"uuid"`
-	Device       string `db:"device"`
-	DeviceSystem string `db:"device_system"`
-	IpAddr       string `db:"ip_addr"`
-	GmtCreate    int64  `db:"gmt_create"`
+	Id           int64               `db:"id"`
+	Uuid         string              `db:"uuid"`
+	Device       string              `db:"device"`
+	DeviceSystem string              `db:"device_system"`
+	IpAddr       lib.EncryptedString `db:"ip_addr"`
+	GmtCreate    int64               `db:"gmt_create"`
 	// 付费套餐
 	SubsExpiresAt int64  `db:"subs_expires_at"`
 	SubsPkgId     string `db:"subs_pkg_id"`
 	// 其他属性
-	FcmToken       string `db:"fcm_token"`
-	Lang           string `db:"lang"`
-	TimezoneOffset int    `db:"timezone_offset"`
+	FcmToken       lib.EncryptedString `db:"fcm_token"`
+	Lang           string              `db:"lang"`
+	TimezoneOffset int                 `db:"timezone_offset"`
 }
 
 func (do UserDO) ToContext() *UserContext {
@@ -42,8 +42,8 @@ CREATE TABLE `user`
     `gmt_create`      bigint(20)                                                    NOT NULL,
     `subs_expires_at` bigint(20)                                                    NOT NULL DEFAULT '0',
     `subs_pkg_id`     varchar(80) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '',
-    `ip_addr`         varchar(16) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '',
-    `fcm_token`       varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '',
+    `ip_addr`         varchar(512) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '',
+    `fcm_token`       varchar(512) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '',
     `lang`            varchar(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci  NOT NULL DEFAULT 'en',
     `timezone_offset` int(11)                                                       NOT NULL DEFAULT '0',
     PRIMARY KEY (`id`),
@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"runtime/debug"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/nacos-group/nacos-sdk-go/v2/inner/uuid"
@@ -20,8 +20,20 @@ import (
 
 var log = lib.GetLogger("scheduler")
 
+// Context 执行上下文；停止调度或者超过执行器的超时时间会被取消，长时间的任务需要检查Done()
 type Context struct {
+	context.Context
 	Logger *zap.SugaredLogger
+	Fence  int64 // 选主的fencing token，写外部存储时可以用来拒绝过期持有者的写入；不参与选主时为0
+	now    time.Time
+}
+
+// Now 业务时间，补数据时是正在补的时间点；并发补数据时只能用它，lib.Now()无法区分
+func (c *Context) Now() time.Time {
+	if !c.now.IsZero() {
+		return c.now
+	}
+	return lib.Now()
 }
 
 type Executor interface {
@@ -32,14 +44,32 @@ type Executor interface {
 	Processing() string               // 进度描述，由程序自己控制
 }
 
+// ExecutorTimeout 单次执行的超时时间，到期后取消Context；不实现或者返回0表示不限制
+type ExecutorTimeout interface {
+	Timeout() time.Duration
+}
+
+func executorTimeout(ex Executor) time.Duration {
+	if e, ok := ex.(ExecutorTimeout); ok {
+		return e.Timeout()
+	}
+	return 0
+}
+
 type ExecutorStatus struct {
 	Status    bool           `json:"status"`     // 是否开启
 	NextGmt   int64          `json:"next_gmt"`   // 下次执行时间
 	LastGmt   int64          `json:"last_gmt"`   // 上次执行开始时间
 	LastSpent string         `json:"last_spent"` // 上次执行花费时间，单位毫秒
 	LastUUID  string         `json:"last_uuid"`  // 上次执行批次
-	Crashed   int            `json:"crashed"`    // 是否崩溃了
+	Crashed   int            `json:"crashed"`    // 窗口内的失败次数
+	Stopped   bool           `json:"stopped"`    // 失败次数超过限制已经停止，需要Resume
+	RetryGmt  int64          `json:"retry_gmt"`  // 失败退避中，重试的时间
+	Leader    bool           `json:"leader"`     // 是否由本实例执行
 	Once      chan time.Time `json:"-"`          // 提前执行
+	running   bool           // 是否有协程在执行，由scheduler.mu保护
+	crash     crashState     // 失败记录，由scheduler.mu保护
+	poll      pollStats      // 轮询任务的执行聚合，只在执行协程里访问
 	// 额外字段
 	Processing string `json:"processing"` // 当前状态，获取时再计算
 	Name       string `json:"name"`       // 任务名称
@@ -50,18 +80,27 @@ var Scheduler = &scheduler{
 	prefix:         conn.PREFIX,
 	executorList:   nil,
 	executorStatus: make(map[string]*ExecutorStatus),
+	leases:         make(map[string]*lease),
 }
 
 type scheduler struct {
+	mu             sync.Mutex // 保护运行期间的启停和选主
+	started        bool
 	prefix         string
-	queue          *redis.Client              // 依赖redis的队列实现
+	queue          redis.UniversalClient      // 依赖redis的队列实现
 	executorList   []Executor                 // 执行器队列
 	executorStatus map[string]*ExecutorStatus // 通过配置文件控制，简单方便
-	current        int64                      // 现在的时间戳，仅用于补数据，暂时仅支持命令行形式
+	leases         map[string]*lease          // 选主，只有持有者执行
+	history        HistoryStore               // 执行记录，默认存redis
+	alerters       []Alerter                  // 失败和停止时通知
+	location       *time.Location             // 按天计算的时区，默认lib.DefaultLocation
+	ctx            context.Context            // Stop时取消
+	cancel         context.CancelFunc
+	wg             sync.WaitGroup // 执行协程数量，Stop时等待退出
 }
 
 // GetAll 获取注册任务状态; 根据prefix排序一下
-// Executor的运行状态；status=false 未开启；crashed>10 已崩溃；now >= next 正在执行; 等待下次执行
+// Executor的运行状态；status=false 未开启；stopped 已崩溃；now >= next 正在执行; 等待下次执行
 func (s *scheduler) GetAll() (ll []*ExecutorStatus) {
 	for _, ex := range s.executorList {
 		status := s.executorStatus[ex.Name()]
@@ -76,24 +115,30 @@ func (s *scheduler) GetAll() (ll []*ExecutorStatus) {
 	return
 }
 
+// Now 当前时间，串行补数据时是正在补的时间点
 func (s *scheduler) Now() time.Time {
-	if s.current == 0 {
-		return time.Now()
-	} else {
-		return time.Unix(s.current, 0)
+	return lib.Now()
+}
+
+// SetLocation 设置按天计算和cron表达式默认使用的时区
+func (s *scheduler) SetLocation(loc *time.Location) {
+	s.location = loc
+}
+
+func (s *scheduler) Location() *time.Location {
+	if s.location != nil {
+		return s.location
 	}
+	return lib.DefaultLocation
 }
 
 func (s *scheduler) DaysAfter(n int64) int64 {
-	return (s.Now().Unix()+28800+n*86400)/86400*86400 - 28800
+	return lib.DaysAfterIn(s.Now(), int(n), s.Location()).Unix()
 }
 
 func (s *scheduler) NextDayWithOffset(offset int64) int64 {
-	next := s.DaysAfter(1) + offset - s.Now().Unix()
-	if next > 86400 {
-		return s.DaysAfter(0) + offset - s.Now().Unix()
-	}
-	return next
+	now := s.Now()
+	return lib.CeilSeconds(lib.NextLocalTime(now, time.Duration(offset)*time.Second, s.Location()).Sub(now))
 }
 
 // Register 添加待执行的任务, prod环境默认执行
@@ -114,6 +159,14 @@ func (s *scheduler) RegisterStatus(status map[string]bool) {
 	}
 }
 
+// LastUUID 最近一次执行的批次，没有执行过或者任务不存在时为空
+func (s *scheduler) LastUUID(name string) string {
+	if status, ok := s.executorStatus[name]; ok {
+		return status.LastUUID
+	}
+	return ""
+}
+
 // Once 手动执行一次；如果任务正在执行的话，那么提醒用户等会再尝试把
 func (s *scheduler) Once(name string) bool {
 	status, ok := s.executorStatus[name]
@@ -129,67 +182,196 @@ func (s *scheduler) Once(name string) bool {
 	}
 }
 
-// Start 开始创建后台执行的任务
+// Start 开始创建后台执行的任务；有DAEMON环境变量时补数据后退出，见BackfillFromEnv
 func (s *scheduler) Start() {
 	if s.queue == nil {
 		s.queue = conn.GetRedis()
 	}
-
 	s.runForCMD()
+	if s.history == nil && s.queue != nil {
+		s.history = &RedisHistory{queue: s.queue, prefix: s.prefix}
+	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.started = true
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	if s.queue == nil {
+		log.Warnw("scheduler without redis, executors run on every instance")
+	}
+	if pruner, ok := s.history.(HistoryPruner); ok {
+		go s.prune(s.ctx, pruner)
+	}
 	for _, ex := range s.executorList {
 		status := s.executorStatus[ex.Name()]
 		if status.Status {
 			fmt.Printf("daemon register %s, status=%v\n", ex.Name(), status)
-			go s.run(ex)
+			s.launch(ex)
 		}
 	}
 }
 
-// 从命令行临时启动，检查是否存在环境变量
-// 格式 DAEMON=executor [START=20060102] [END=20060102] [SLEEP=10]
-func (s *scheduler) runForCMD() {
-	daemon := os.Getenv("DAEMON")
-	if len(daemon) == 0 {
+// launch 启动执行协程，调用方需要持有s.mu
+func (s *scheduler) launch(ex Executor) {
+	status := s.executorStatus[ex.Name()]
+	if status.running {
 		return
 	}
-	sleep := lib.StrToInt64(os.Getenv("SLEEP"))
-	time.Sleep(time.Duration(sleep) * time.Second)
+	// 没有配置redis时和原来一样每个实例都执行
+	if _, ok := s.leases[ex.Name()]; !ok && !runEverywhere(ex) && s.queue != nil {
+		l := newLease(s.queue, s.prefix, ex.Name())
+		s.leases[ex.Name()] = l
+		go l.keep()
+	}
+	status.running = true
+	s.wg.Add(1)
+	go s.run(ex)
+}
 
-	start := lib.ParseTime("20060102", os.Getenv("START"))
-	end := lib.ParseTime("20060102", os.Getenv("END"))
-	if end == 0 {
-		end = start
+// Stop 通知所有任务退出，等待正在执行的任务完成或者ctx到期；
+// 锁在各自的执行协程退出时释放，超时后仍在执行的任务继续持有，避免其他实例同时执行
+func (s *scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.started {
+		s.mu.Unlock()
+		return nil
 	}
-	if start == 0 {
-		start = lib.DaysAfter(0)
-		end = start
+	s.started = false // 之后不再启动新的协程
+	s.cancel()
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+		log.Warnw("scheduler stop timeout", "err", err)
 	}
-	name := os.Getenv("DAEMON")
-	for ; start <= end; start += 86400 {
-		s.current = start
-		fmt.Println(s.Now().Format("20060102"), "start")
-		var err = errors.New("not found")
-		for _, ex := range s.executorList {
-			if ex.Name() == name {
-				c := NewSchedulerContext(ex.Name())
-				err = ex.Process(c)
-			}
+	return err
+}
+
+func (s *scheduler) lease(name string) (l *lease, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok = s.leases[name]
+	return
+}
+
+// 执行协程退出，无论是停止、关闭还是崩溃都释放锁让其他实例接管，重新启动时再抢占
+func (s *scheduler) exit(name string) {
+	s.mu.Lock()
+	s.executorStatus[name].running = false
+	s.executorStatus[name].Leader = false
+	if l, ok := s.leases[name]; ok {
+		l.release()
+		delete(s.leases, name)
+	}
+	s.mu.Unlock()
+	s.wg.Done()
+}
+
+func (s *scheduler) executor(name string) Executor {
+	for _, ex := range s.executorList {
+		if ex.Name() == name {
+			return ex
+		}
+	}
+	return nil
+}
+
+// SetStatus 运行期间开启或关闭任务，关闭时正在执行的任务会执行完
+func (s *scheduler) SetStatus(name string, val bool) bool {
+	ex := s.executor(name)
+	if ex == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := s.executorStatus[name]
+	status.Status = val
+	if !s.started {
+		return true
+	}
+	if val {
+		s.launch(ex)
+	} else {
+		select { // 唤醒等待中的协程，让它退出
+		case status.Once <- time.Now():
+		default:
+		}
+	}
+	return true
+}
+
+// Resume 清空失败记录，已经因为崩溃停止的任务会重新启动；正在退避的任务会立即重试
+func (s *scheduler) Resume(name string) bool {
+	ex := s.executor(name)
+	if ex == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := s.executorStatus[name]
+	status.crash.reset()
+	status.Crashed = 0
+	status.Stopped = false
+	if !s.started || !status.Status {
+		return true
+	}
+	if status.running {
+		select {
+		case status.Once <- time.Now():
+		default:
 		}
-		fmt.Println(s.Now().Format("20060102"), "complete", err)
+	} else {
+		s.launch(ex)
 	}
-	os.Exit(0)
+	return true
+}
+
+// 记录执行结果，返回是否需要停止以及下次执行前的等待时间
+func (s *scheduler) settle(ex Executor, status *ExecutorStatus, uid string, err error) (stop bool, wait time.Duration) {
+	p := restartPolicy(ex)
+	s.mu.Lock()
+	if err == nil {
+		if status.crash.succeed(p) {
+			status.Crashed = 0
+		}
+		s.mu.Unlock()
+		return
+	}
+	stop, wait = status.crash.fail(p, time.Now())
+	status.Crashed = len(status.crash.failures)
+	status.Stopped = stop
+	s.mu.Unlock()
+
+	a := Alert{Event: AlertCrash, Name: ex.Name(), Desc: ex.Desc(), UUID: uid, Err: err.Error(), Crashed: status.Crashed}
+	if stop {
+		a.Event = AlertStop
+	} else if wait > 0 {
+		a.Retry = time.Now().Add(wait) // 退避结束后直接重试，不等下一个时间点
+	}
+	s.alert(a)
+	return
 }
 
 func NewSchedulerContext(name string) *Context {
 	uid, _ := uuid.NewV4()
-	return &Context{Logger: log.With("name", name, "uuid", uid.String())}
+	return &Context{Context: context.Background(), Logger: log.With("name", name, "uuid", uid.String())}
 }
 
 func (s *scheduler) run(ex Executor) {
+	defer s.exit(ex.Name())
+	defer s.flushPoll(ex, s.executorStatus[ex.Name()])
+	retry := false // 失败退避后立即重试，不等下一个时间点
 	for {
 		uid, _ := uuid.NewV4()
-		c := &Context{Logger: log.With("name", ex.Name(), "uuid", uid.String())}
+		c := &Context{Context: s.ctx, Logger: log.With("name", ex.Name(), "uuid", uid.String())}
 		status := s.executorStatus[ex.Name()]
 		// 判断什么时候执行
 		next := time.Duration(ex.NextDuration())
@@ -200,17 +382,41 @@ func (s *scheduler) run(ex Executor) {
 		if next == 0 {
 			next = 400 * time.Millisecond
 			showStart = false
+		} else if !retry { // 按业务时间等待，FakeClock可以推进
+			status.NextGmt = lib.Now().Unix() + int64(next)
+			next = next * time.Second
+			c.Logger.Infow("daemon waiting", "at", lib.Now().Add(next).Format("2006-01-02 15:04:05"))
 		} else {
-			status.NextGmt = time.Now().Unix() + int64(next)
 			next = next * time.Second
-			c.Logger.Infow("daemon waiting", "at", time.Now().Add(next).Format("2006-01-02 15:04:05"))
 		}
 		// 允许提前执行
-		select {
-		case <-status.Once:
-			break
-		case <-time.After(next):
-			break
+		manual := false
+		if retry {
+			retry = false
+			c.Logger.Infow("daemon retry", "crashed", status.Crashed)
+		} else {
+			select {
+			case <-status.Once:
+				manual = true
+			case <-lib.After(next):
+			case <-s.ctx.Done():
+				c.Logger.Infow("daemon stopped")
+				return
+			}
+		}
+		if !status.Status { // 运行期间被关闭了
+			c.Logger.Infow("daemon disabled")
+			return
+		}
+		// 非持有者跳过本次；手动触发的视为运维操作，总是执行
+		if l, ok := s.lease(ex.Name()); ok {
+			c.Fence = l.Fence()
+			status.Leader = l.Held()
+			if !status.Leader && !manual {
+				continue
+			}
+		} else {
+			status.Leader = true
 		}
 		if showStart {
 			c.Logger.Infow("daemon start")
@@ -218,22 +424,50 @@ func (s *scheduler) run(ex Executor) {
 		status.LastUUID = uid.String() // 开始执行才修改，日志可以查看上一次的
 
 		gmtStart := time.Now().UnixNano()
-		if err := func() (err error) { // 使用闭包开始执行
+		var stack string
+		err := func() (err error) { // 使用闭包开始执行
+			if timeout := executorTimeout(ex); timeout > 0 {
+				var cancel context.CancelFunc
+				c.Context, cancel = context.WithTimeout(s.ctx, timeout)
+				defer cancel()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					fmt.Println("daemon run panic, name", ex.Name(), r)
-					debug.PrintStack()
+					trace := debug.Stack()
+					fmt.Println(string(trace))
+					stack = fmt.Sprintf("%v\n%s", r, trace)
 
 					err = errors.New("panic")
 				}
 			}()
 			return ex.Process(c)
-		}(); err != nil {
-			status.Crashed += 1
-			if status.Crashed > 10 {
-				c.Logger.Errorw("daemon stop")
+		}()
+		// 轮询类的任务成功时聚合记录
+		if showStart || manual || err != nil {
+			s.record(ex, uid.String(), gmtStart, err, stack)
+		} else {
+			s.recordPoll(ex, status, uid.String(), gmtStart)
+		}
+		if s.ctx.Err() != nil { // 停止期间被取消的不算崩溃
+			return
+		}
+		stop, wait := s.settle(ex, status, uid.String(), err)
+		if stop {
+			c.Logger.Errorw("daemon stop", "crashed", status.Crashed)
+			return
+		}
+		if wait > 0 { // 退避期间可以手动执行或者Resume提前重试
+			status.RetryGmt = time.Now().Add(wait).Unix()
+			c.Logger.Warnw("daemon backoff", "crashed", status.Crashed, "wait", wait.String())
+			select {
+			case <-status.Once:
+			case <-time.After(wait):
+			case <-s.ctx.Done():
 				return
 			}
+			status.RetryGmt = 0
+			retry = true
 		}
 
 		if next > 0 {
@@ -246,62 +480,3 @@ func (s *scheduler) run(ex Executor) {
 		}
 	}
 }
-
-func (s *scheduler) key(name string) string {
-	return fmt.Sprintf("%v:%v", s.prefix, name)
-}
-
-// Add 添加一个待执行的任务数据包
-func (s *scheduler) Add(name, member string, after int64) {
-	gmtExpire := float64(time.Now().Unix() + after)
-	//s.Logger.Infow("add delay", "name", name, "member", member, "expire", gmtExpire)
-	if err := s.queue.ZAdd(context.Background(), s.key(name), &redis.Z{Score: gmtExpire, Member: member}).Err(); err != nil {
-		log.Errorw("put member failed", "name", name, "member", member, "after", after)
-	}
-}
-
-const min = "-inf"
-
-// Len 估算消息队列长度
-func (s *scheduler) Len(name string) (cnt int64) {
-	cnt, _ = s.queue.ZCard(context.Background(), s.key(name)).Result()
-	return
-}
-
-// GetBatch 获取到执行任务时所有的任务，通过queue传出去
-func (s *scheduler) GetBatch(name, max string, handler func(item redis.Z)) {
-	var count int64 = 2000
-	var offset int64 = 0
-	defer func() {
-		if offset > 0 {
-			s.clearBatch(name, max)
-		}
-	}()
-	for {
-		batch, err := s.queue.ZRangeByScoreWithScores(context.Background(), s.key(name), &redis.ZRangeBy{
-			Min:    min,
-			Max:    max,
-			Offset: offset,
-			Count:  count,
-		}).Result()
-		if err != nil {
-			log.Errorw("get batch failed", "name", name, "err", err)
-			return
-		}
-		batchLen := int64(len(batch))
-		for _, item := range batch {
-			handler(item)
-		}
-		// next
-		offset += batchLen
-		if batchLen < count {
-			return
-		}
-	}
-}
-
-// clearBatch 清除已经获取的批次
-func (s *scheduler) clearBatch(name, max string) {
-	cnt, _ := s.queue.ZRemRangeByScore(context.Background(), s.key(name), min, max).Result()
-	log.Infow("clear batch success", "name", name, "cnt", cnt)
-}
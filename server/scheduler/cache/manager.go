@@ -1,17 +1,30 @@
 package cache
 
 import (
+	"container/heap"
 	"context"
 	"fmt"
-	jsoniter "github.com/json-iterator/go"
-	"github.com/scys-devs/lib-go/conn"
+	"sort"
 	"sync"
 	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/scys-devs/lib-go"
+	"github.com/scys-devs/lib-go/conn"
+)
+
+var (
+	EvictRounds    int64 = 10   // 连续多少个更新周期没有访问，就不再维护
+	RefreshWorkers       = 4    // 同时执行更新的最大数量
+	MaxBackoff     int64 = 3600 // 更新失败后，最长的重试间隔
+
+	logger = lib.GetLogger("cache_updater")
 )
 
 type Key struct {
 	Name   string
 	Format string
+	Tags   []string // 额外的失效标签，例如 user:42
 }
 
 // FormatKey 暂时只支持%v的格式化
@@ -22,15 +35,33 @@ func FormatKey(format string, a ...any) Key {
 	}
 }
 
+// WithTags 附加失效标签，可以通过InvalidateTag批量删除
+func (key Key) WithTags(tags ...string) Key {
+	key.Tags = append(append([]string(nil), key.Tags...), tags...)
+	return key
+}
+
 type CacheItem struct {
+	Name   string
+	Format string
 	Last   int64               // 访问时间
-	NewFun func() (any, error) // 待更新的闭包函数
+	NewFun func() (any, error) // 待更新的闭包函数，重启后恢复的key在下次访问前为空
+	Opt    conn.CacheOption    // 写入时使用的编码、标签
+	// 更新状态
+	Next    int64  // 下次更新时间
+	Errors  int    // 连续失败次数
+	LastErr string // 最近一次失败原因
+	index   int    // 在更新队列中的位置，-1 表示不在队列中（正在更新）
 }
 
 type Cache struct {
 	sync.RWMutex
-	Keys     map[string]CacheItem // key名和访问时间
-	Duration int64
+	Keys      map[string]CacheItem // key名和访问时间，只读；由CacheUpdater维护
+	Format    string
+	Duration  int64
+	Refreshed int64  // 最近一次成功更新的时间
+	LastErr   string // 最近一次失败原因
+	LastErrAt int64
 }
 
 // 缓存十倍的时间，但最多2天
@@ -42,107 +73,402 @@ func (cache *Cache) Expire() int64 {
 	return expire
 }
 
-func (cache *Cache) Update() error {
-	cache.RLock()
-	defer cache.RUnlock()
-	// 遍历key底下的值，然后判断是否要刷新
-	now := time.Now().Unix()
-	for name, item := range cache.Keys {
-		//fmt.Println("开始检测缓存", name)
-		if now-item.Last > cache.Duration {
-			//fmt.Println(name, "太久不访问了")
-			continue
-		}
-		// 判断下缓存剩余时间
-		expire := conn.GetRedis().TTL(context.TODO(), conn.GetRedisKey(name)).Val()
-		expireTS := cache.Expire() - int64(expire/time.Second) // 真正消耗的时间
-		//fmt.Println(name, "已缓存时间", expireTS)
-		if expireTS < cache.Duration {
-			continue
-		}
-		// 准备更新
-		d, err := item.NewFun()
+// FormatStatus 每个格式的维护情况
+type FormatStatus struct {
+	Format    string `json:"format"`
+	Duration  int64  `json:"duration"`
+	Keys      int    `json:"keys"`    // 正在维护的key数量
+	Dormant   int    `json:"dormant"` // 重启后恢复、等待访问的key数量
+	Failing   int    `json:"failing"` // 正在退避重试的key数量
+	Refreshed int64  `json:"refreshed"`
+	LastErr   string `json:"last_err"`
+	LastErrAt int64  `json:"last_err_at"`
+}
+
+// 按下次更新时间排序的小顶堆
+type refreshQueue []*CacheItem
+
+func (q refreshQueue) Len() int           { return len(q) }
+func (q refreshQueue) Less(i, j int) bool { return q[i].Next < q[j].Next }
+func (q refreshQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+func (q *refreshQueue) Push(x any) {
+	item := x.(*CacheItem)
+	item.index = len(*q)
+	*q = append(*q, item)
+}
+func (q *refreshQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	item.index = -1
+	*q = old[:len(old)-1]
+	return item
+}
+
+// 持久化到redis的信息，重启后用于恢复访问时间和状态
+type persistItem struct {
+	Format   string `json:"format"`
+	Duration int64  `json:"duration"`
+	Last     int64  `json:"last"`
+}
+
+type updaterMap struct {
+	sync.RWMutex
+	Map      map[string]*Cache // 格式 -> 维护信息，读写需要加锁
+	items    map[string]*CacheItem
+	queue    refreshQueue
+	workers  chan struct{}
+	loadOnce sync.Once
+	dirty    map[string]bool // 需要持久化的key
+	flushed  int64
+}
+
+var CacheUpdater = &updaterMap{
+	Map:   make(map[string]*Cache),
+	items: make(map[string]*CacheItem),
+	dirty: make(map[string]bool),
+}
+
+func (m *updaterMap) persistKey() string {
+	return conn.K("cache_updater")
+}
+
+// 从redis恢复上次维护的key，函数要等到下次访问时才能拿到
+func (m *updaterMap) load() {
+	m.loadOnce.Do(func() {
+		raw, err := conn.GetRedis().HGetAll(context.TODO(), m.persistKey()).Result()
 		if err != nil {
-			return err
+			logger.Errorw("load updater", "err", err)
+			return
 		}
-		s, _ := jsoniter.MarshalToString(d)
-		// 缓存
-		expire = time.Duration(cache.Expire()) * time.Second
-		_ = conn.GetRedis().Set(context.TODO(), conn.GetRedisKey(name), s, expire).Val()
-		//fmt.Println(name, "成功更新缓存")
+		now := time.Now().Unix()
+		m.Lock()
+		defer m.Unlock()
+		for name, v := range raw {
+			var p persistItem
+			if err := jsoniter.UnmarshalFromString(v, &p); err != nil || p.Duration <= 0 {
+				continue
+			}
+			if _, ok := m.items[name]; ok {
+				continue
+			}
+			cache := m.format(p.Format, p.Duration)
+			item := &CacheItem{Name: name, Format: p.Format, Last: p.Last, Next: now + p.Duration}
+			m.items[name] = item
+			heap.Push(&m.queue, item)
+			cache.keep(item)
+		}
+	})
+}
+
+func (m *updaterMap) format(format string, duration int64) *Cache {
+	cache, ok := m.Map[format]
+	if !ok {
+		cache = &Cache{Format: format, Duration: duration, Keys: make(map[string]CacheItem)}
+		m.Map[format] = cache
 	}
-	return nil
+	return cache
 }
 
-func (cache *Cache) Write(key string, cacheItem CacheItem) {
+// 同步到Cache.Keys，兼容直接读取Keys的旧代码；调用时需要持有m的写锁
+func (cache *Cache) keep(item *CacheItem) {
 	cache.Lock()
-	cache.Keys[key] = cacheItem
+	if cache.Keys == nil {
+		cache.Keys = make(map[string]CacheItem)
+	}
+	cache.Keys[item.Name] = CacheItem{Name: item.Name, Format: item.Format, Last: item.Last, NewFun: item.NewFun}
 	cache.Unlock()
 }
 
-type updaterMap struct {
-	sync.RWMutex
-	Map map[string]*Cache
+func (cache *Cache) drop(name string) {
+	cache.Lock()
+	delete(cache.Keys, name)
+	cache.Unlock()
 }
 
-func (m *updaterMap) Read(key string) (*Cache, bool) {
+// Touch 记录访问，新key在一个周期后开始更新
+func (m *updaterMap) Touch(key Key, duration int64, newFun func() (any, error), opt conn.CacheOption) *Cache {
+	now := time.Now().Unix()
+	// 同一秒内已经记录过，只需要读锁
 	m.RLock()
-	cache, ok := m.Map[key]
+	if item, ok := m.items[key.Name]; ok && item.Last == now && item.NewFun != nil {
+		cache := m.Map[key.Format]
+		m.RUnlock()
+		return cache
+	}
 	m.RUnlock()
-	return cache, ok
+
+	m.Lock()
+	defer m.Unlock()
+	cache := m.format(key.Format, duration)
+	item, ok := m.items[key.Name]
+	if !ok {
+		item = &CacheItem{Name: key.Name, Format: key.Format, Next: now + cache.Duration}
+		m.items[key.Name] = item
+		heap.Push(&m.queue, item)
+	}
+	item.Last = now
+	item.NewFun = newFun
+	item.Opt = opt
+	m.dirty[key.Name] = true
+	cache.keep(item)
+	return cache
 }
 
-func (m *updaterMap) Write(key string, cache *Cache) {
+// Forget 不再维护这些key
+func (m *updaterMap) Forget(names ...string) {
 	m.Lock()
-	m.Map[key] = cache
+	for _, name := range names {
+		if item, ok := m.items[name]; ok {
+			if item.index >= 0 {
+				heap.Remove(&m.queue, item.index)
+			}
+			delete(m.items, name)
+			delete(m.dirty, name)
+			m.Map[item.Format].drop(name)
+		}
+	}
 	m.Unlock()
+	if len(names) > 0 {
+		_ = conn.GetRedis().HDel(context.TODO(), m.persistKey(), names...).Err()
+	}
 }
 
+// Update 取出到期的key交给worker更新，太久没访问的key直接淘汰
 func (m *updaterMap) Update() {
-	m.RLock()
-	for _, cache := range m.Map {
-		if err := cache.Update(); err != nil {
-			_ = cache.Update()
+	m.load()
+
+	now := time.Now().Unix()
+	var due []*CacheItem
+	var evicted []string
+	m.Lock()
+	if m.workers == nil {
+		m.workers = make(chan struct{}, RefreshWorkers)
+	}
+	workers := m.workers
+	for m.queue.Len() > 0 && m.queue[0].Next <= now {
+		item := heap.Pop(&m.queue).(*CacheItem)
+		cache := m.Map[item.Format]
+		if now-item.Last > cache.Duration*EvictRounds {
+			delete(m.items, item.Name)
+			delete(m.dirty, item.Name)
+			cache.drop(item.Name)
+			evicted = append(evicted, item.Name)
+			continue
+		}
+		if item.NewFun == nil || now-item.Last > cache.Duration { // 暂时没人访问，等下个周期再看
+			item.Next = now + cache.Duration
+			heap.Push(&m.queue, item)
+			continue
 		}
+		due = append(due, item)
 	}
-	m.RUnlock()
+	m.Unlock()
+
+	if len(evicted) > 0 {
+		_ = conn.GetRedis().HDel(context.TODO(), m.persistKey(), evicted...).Err()
+	}
+	for _, item := range due {
+		workers <- struct{}{}
+		go func(item *CacheItem) {
+			defer func() { <-workers }()
+			_ = m.refresh(item, false)
+		}(item)
+	}
+	m.flush(now)
 }
 
-func (m *updaterMap) UpdateNow(name string) error {
-	m.RLock()
-	var err error
-	for n, cache := range CacheUpdater.Map {
-		if n == name {
-			err = cache.Update()
+// 每分钟把访问时间写回redis
+func (m *updaterMap) flush(now int64) {
+	values := make(map[string]interface{})
+	m.Lock()
+	if now-m.flushed < 60 {
+		m.Unlock()
+		return
+	}
+	m.flushed = now
+	for name := range m.dirty {
+		item := m.items[name]
+		values[name], _ = jsoniter.MarshalToString(persistItem{
+			Format:   item.Format,
+			Duration: m.Map[item.Format].Duration,
+			Last:     item.Last,
+		})
+	}
+	m.dirty = make(map[string]bool)
+	m.Unlock()
+	if len(values) > 0 {
+		_ = conn.GetRedis().HSet(context.TODO(), m.persistKey(), values).Err()
+	}
+}
+
+// 执行更新时不持有锁，更新完重新放回队列；force为false时其他实例刚更新过的key跳过
+func (m *updaterMap) refresh(item *CacheItem, force bool) (err error) {
+	m.Lock()
+	newFun, opt, name := item.NewFun, item.Opt, item.Name
+	cache := m.Map[item.Format]
+	m.Unlock()
+
+	if !force {
+		if elapsed, ok := m.elapsed(cache, name, opt); ok && elapsed < cache.Duration {
+			m.Lock()
+			item.Next = time.Now().Unix() + cache.Duration - elapsed
+			if m.items[name] == item && item.index < 0 {
+				heap.Push(&m.queue, item)
+			}
+			m.Unlock()
+			return nil
 		}
 	}
-	m.RUnlock()
+
+	d, err := newFun()
+	if err == nil {
+		err = conn.SetCache(name, d, cache.Expire(), opt)
+	}
+	if err == nil {
+		conn.InvalidateLocalCache(name)
+	}
+
+	now := time.Now().Unix()
+	m.Lock()
+	defer m.Unlock()
+	if err != nil {
+		item.Errors++
+		item.LastErr = err.Error()
+		cache.LastErr = item.LastErr
+		cache.LastErrAt = now
+		item.Next = now + lib.Min(cache.Duration<<lib.Min(item.Errors, 16), MaxBackoff)
+		logger.Errorw("refresh cache", "name", name, "errors", item.Errors, "err", err)
+	} else {
+		item.Errors = 0
+		item.LastErr = ""
+		cache.Refreshed = now
+		item.Next = now + cache.Duration
+	}
+	if m.items[name] == item && item.index < 0 { // 更新期间可能被删除了
+		heap.Push(&m.queue, item)
+	}
 	return err
 }
 
-var CacheUpdater = &updaterMap{
-	Map: make(map[string]*Cache),
+// 距离上次写入redis过了多久，根据剩余的过期时间计算
+func (m *updaterMap) elapsed(cache *Cache, name string, opt conn.CacheOption) (int64, bool) {
+	ttl := conn.GetRedis().TTL(context.TODO(), conn.GetRedisKey(name)).Val()
+	if ttl <= 0 {
+		return 0, false
+	}
+	return cache.Expire() + int64(opt.Stale/time.Second) - int64(ttl/time.Second), true
+}
+
+// UpdateNow 立即更新某个格式下所有的key，不检查其他实例是否刚更新过；返回第一个错误
+func (m *updaterMap) UpdateNow(format string) (err error) {
+	var ll []*CacheItem
+	m.Lock()
+	for _, item := range m.items {
+		if item.Format == format && item.index >= 0 && item.NewFun != nil {
+			heap.Remove(&m.queue, item.index)
+			ll = append(ll, item)
+		}
+	}
+	m.Unlock()
+	for _, item := range ll {
+		if e := m.refresh(item, true); e != nil && err == nil {
+			err = e
+		}
+	}
+	return
+}
+
+// Status 获取每个格式的维护情况
+func (m *updaterMap) Status() (ll []FormatStatus) {
+	m.Lock()
+	status := make(map[string]*FormatStatus)
+	for format, cache := range m.Map {
+		status[format] = &FormatStatus{
+			Format:    format,
+			Duration:  cache.Duration,
+			Refreshed: cache.Refreshed,
+			LastErr:   cache.LastErr,
+			LastErrAt: cache.LastErrAt,
+		}
+	}
+	for _, item := range m.items {
+		s := status[item.Format]
+		s.Keys++
+		if item.NewFun == nil {
+			s.Dormant++
+		}
+		if item.Errors > 0 {
+			s.Failing++
+		}
+	}
+	m.Unlock()
+
+	for _, s := range status {
+		ll = append(ll, *s)
+	}
+	sort.Slice(ll, func(i, j int) bool {
+		return ll[i].Format < ll[j].Format
+	})
+	return
 }
 
 // 注册后，自动维护缓存；如果长期无人访问的话，也可以取消维护了
 func ResignCacheFromRedis[T any](key Key, duration int64, newFun func() (T, error), force ...bool) (ret T) {
-	if _, ok := CacheUpdater.Read(key.Format); !ok {
-		CacheUpdater.Write(key.Format, &Cache{
-			Keys:     make(map[string]CacheItem),
-			Duration: duration,
-		})
-	}
+	return ResignCacheFromRedisWith(key, duration, newFun, conn.CacheOption{Force: len(force) > 0 && force[0]})
+}
+
+// ResignCacheFromRedisWith 支持进程内缓存等配置，opt.Format 固定使用key.Format，标签会加上key.Tags和format标签
+func ResignCacheFromRedisWith[T any](key Key, duration int64, newFun func() (T, error), opt conn.CacheOption) (ret T) {
+	opt.Format = key.Format
+	opt.Tags = append(append(append([]string(nil), opt.Tags...), key.Tags...), formatTag(key.Format))
 
 	// 记录下缓存的访问时间
-	cache, _ := CacheUpdater.Read(key.Format)
-	cache.Write(key.Name, CacheItem{
-		Last: time.Now().Unix(),
-		NewFun: func() (any, error) {
-			return newFun()
-		},
-	})
+	cache := CacheUpdater.Touch(key, duration, func() (any, error) {
+		return newFun()
+	}, opt)
 
 	expire := cache.Expire()
-	ret = conn.GetCacheFromRedis(key.Name, expire, newFun, force...)
+	ret = conn.GetCacheFromRedisWith(key.Name, expire, newFun, opt)
 	return
 }
+
+// Read 获取格式的维护信息
+//
+// Deprecated: 使用Status
+func (m *updaterMap) Read(format string) (*Cache, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	cache, ok := m.Map[format]
+	return cache, ok
+}
+
+// Write 设置格式的维护信息
+//
+// Deprecated: 由ResignCacheFromRedis自动维护
+func (m *updaterMap) Write(format string, cache *Cache) {
+	if cache.Format == "" {
+		cache.Format = format
+	}
+	m.Lock()
+	m.Map[format] = cache
+	m.Unlock()
+}
+
+// Update 立即更新这个格式下所有的key
+//
+// Deprecated: 使用CacheUpdater.UpdateNow
+func (cache *Cache) Update() error {
+	return CacheUpdater.UpdateNow(cache.Format)
+}
+
+// Write 记录key的访问
+//
+// Deprecated: 使用ResignCacheFromRedis
+func (cache *Cache) Write(key string, item CacheItem) {
+	CacheUpdater.Touch(Key{Name: key, Format: cache.Format}, cache.Duration, item.NewFun, item.Opt)
+}
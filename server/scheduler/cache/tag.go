@@ -0,0 +1,21 @@
+package cache
+
+import (
+	"github.com/scys-devs/lib-go/conn"
+)
+
+func formatTag(format string) string {
+	return "format:" + format
+}
+
+// InvalidateTag 删除标签下的所有缓存，并且不再自动更新
+func InvalidateTag(tags ...string) error {
+	keys, err := conn.InvalidateTag(tags...)
+	CacheUpdater.Forget(keys...)
+	return err
+}
+
+// InvalidateFormat 删除同一个格式下的所有缓存，例如 product:%v
+func InvalidateFormat(format string) error {
+	return InvalidateTag(formatTag(format))
+}
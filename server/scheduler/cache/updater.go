@@ -18,6 +18,11 @@ func (*Updater) Name() string {
 	return "cache_update"
 }
 
+// RunEverywhere 每个实例维护自己访问过的key
+func (*Updater) RunEverywhere() bool {
+	return true
+}
+
 func (*Updater) NextDuration() int64 {
 	return 0
 }
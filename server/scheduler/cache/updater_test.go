@@ -39,3 +39,22 @@ func TestUpdater_Process(t *testing.T) {
 
 	time.Sleep(time.Hour)
 }
+
+// 旧代码直接读取Cache.Keys
+func TestCache_Keys(t *testing.T) {
+	key := FormatKey("keys:%v", 1)
+	newFun := func() (any, error) { return 1, nil }
+	cache := CacheUpdater.Touch(key, 60, newFun, conn.CacheOption{})
+	if again := CacheUpdater.Touch(key, 60, newFun, conn.CacheOption{}); again != cache {
+		t.Fatal("touch returned another cache")
+	}
+	cache.RLock()
+	item, ok := cache.Keys[key.Name]
+	cache.RUnlock()
+	if !ok || item.Last == 0 || item.NewFun == nil {
+		t.Errorf("keys = %v", cache.Keys)
+	}
+	if got, _ := CacheUpdater.Read(key.Format); got != cache {
+		t.Errorf("read = %v", got)
+	}
+}
@@ -15,7 +15,8 @@ import (
 var client *asynq.Client
 
 type ExecAsynq struct {
-	Addr      []string // redis服务
+	Addr      []string // redis服务，为空时复用conn.NewRedisWith的配置
+	DB        int      // asynq使用的数据库，为0时线上用4、测试环境用5，不占用默认数据库
 	WhiteList []int64  // 通过user_id来判断
 	Send      func(m DO) error
 	OnMessage func(m DO)
@@ -23,28 +24,82 @@ type ExecAsynq struct {
 	DAO       MessageDAO
 }
 
-func (e *ExecAsynq) Start() {
+// AsynqRedisOpt 将conn的redis配置转换为asynq的连接配置；asynq依赖的go-redis版本不同，只能共享配置不能共享client
+func AsynqRedisOpt(cfg conn.RedisConfig, db int) asynq.RedisConnOpt {
+	opt := cfg.Universal()
+	if cfg.Cluster || (len(cfg.MasterName) == 0 && len(cfg.Addrs) > 1) {
+		return asynq.RedisClusterClientOpt{
+			Addrs:        opt.Addrs,
+			Username:     opt.Username,
+			Password:     opt.Password,
+			DialTimeout:  opt.DialTimeout,
+			ReadTimeout:  opt.ReadTimeout,
+			WriteTimeout: opt.WriteTimeout,
+			TLSConfig:    opt.TLSConfig,
+		}
+	}
+	if len(cfg.MasterName) > 0 {
+		return asynq.RedisFailoverClientOpt{
+			MasterName:       opt.MasterName,
+			SentinelAddrs:    opt.Addrs,
+			SentinelPassword: opt.SentinelPassword,
+			Username:         opt.Username,
+			Password:         opt.Password,
+			DB:               db,
+			DialTimeout:      opt.DialTimeout,
+			ReadTimeout:      opt.ReadTimeout,
+			WriteTimeout:     opt.WriteTimeout,
+			PoolSize:         opt.PoolSize,
+			TLSConfig:        opt.TLSConfig,
+		}
+	}
+	return asynq.RedisClientOpt{
+		Addr:         opt.Addrs[0],
+		Username:     opt.Username,
+		Password:     opt.Password,
+		DB:           db,
+		DialTimeout:  opt.DialTimeout,
+		ReadTimeout:  opt.ReadTimeout,
+		WriteTimeout: opt.WriteTimeout,
+		PoolSize:     opt.PoolSize,
+		TLSConfig:    opt.TLSConfig,
+	}
+}
+
+func (e *ExecAsynq) redisOpt() asynq.RedisConnOpt {
+	db := e.DB
+	if db == 0 {
+		db = 4
+		if len(conn.ENV) > 0 {
+			db = 5
+		}
+	}
+	if len(e.Addr) > 0 {
+		return asynq.RedisClientOpt{Addr: e.Addr[0], DB: db}
+	}
+	if cfg := conn.GetRedisConfig(); len(cfg.Addrs) > 0 {
+		return AsynqRedisOpt(cfg, db)
+	}
+	// 没有初始化全局redis时，兼容之前的默认地址
 	var addr = "localhost:6379"
 	if conn.ENV == "local-docker" {
 		addr = fmt.Sprintf("%v:6379", conn.HostDockerInternal)
 	}
-	if len(e.Addr) > 0 {
-		addr = e.Addr[0]
-	}
+	return asynq.RedisClientOpt{Addr: addr, DB: db}
+}
+
+func (e *ExecAsynq) Start() {
 	if e.DAO == nil {
 		//e.DAO = mysqlDao{}
 		e.DAO = GetESDao()
 	}
 
-	db := 4 // 不用默认数据库
-	if len(conn.ENV) > 0 {
-		db = 5
-	}
+	opt := e.redisOpt()
 	// 初始化客户端
-	client = asynq.NewClient(asynq.RedisClientOpt{Addr: addr, DB: db})
+	client = asynq.NewClient(opt)
 	// 初始化服务端
 	srv := asynq.NewServer(
-		asynq.RedisClientOpt{Addr: addr, DB: db},
+		opt,
 		asynq.Config{
 			Concurrency: 10,
 			Queues: map[string]int{
@@ -5,8 +5,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/scys-devs/lib-go"
 	"github.com/scys-devs/lib-go/conn"
-	"github.com/scys-devs/lib-go/server"
 )
 
 //var dao MessageDAO
@@ -24,20 +24,20 @@ type mysqlDao struct {
 }
 
 func (mysqlDao) Put(m DO) interface{} {
-	res, _ := conn.GetDB().Exec(`insert into message_bus (user_id, group_id, gmt_create, sent) values (?,?,?,?)`,
+	res, _ := conn.GetMysqlDB().Exec(`insert into message_bus (user_id, group_id, gmt_create, sent) values (?,?,?,?)`,
 		m.UserId, m.GroupID(), time.Now().Unix(), m.Sent)
 	id, _ := res.LastInsertId()
 	return id
 }
 
 func (mysqlDao) CountInPeriod(periodStart, periodEnd, userId int64, groupId string) (count int) {
-	_ = conn.GetDB().Get(&count, "SELECT COUNT(*) FROM message_bus WHERE user_id=? AND group_id=? AND sent=1 AND gmt_create BETWEEN ? AND ?",
+	_ = conn.GetMysqlDB().Get(&count, "SELECT COUNT(*) FROM message_bus WHERE user_id=? AND group_id=? AND sent=1 AND gmt_create BETWEEN ? AND ?",
 		userId, groupId, periodStart, periodEnd)
 	return
 }
 
 func (mysqlDao) CountAll(userId int64, groupId string) (count int) {
-	_ = conn.GetDB().Get(&count, "SELECT COUNT(*) FROM message_bus WHERE user_id=? AND group_id=? AND sent=1",
+	_ = conn.GetMysqlDB().Get(&count, "SELECT COUNT(*) FROM message_bus WHERE user_id=? AND group_id=? AND sent=1",
 		userId, groupId)
 	return
 }
@@ -125,7 +125,7 @@ func IsLimit(m DO, dao MessageDAO) bool {
 	if m.PeriodLimit.Period == -1 { // 整个周期限制次数
 		cnt = dao.CountAll(m.UserId, m.GroupID())
 	} else {
-		start := (server.Scheduler.Now().Unix()+m.PeriodLimit.Phase)/m.PeriodLimit.Period*m.PeriodLimit.Period - m.PeriodLimit.Phase
+		start := m.PeriodLimit.start(lib.Now())
 		end := start + m.PeriodLimit.Period
 		cnt = dao.CountInPeriod(start, end, m.UserId, m.GroupID())
 	}
@@ -1,21 +1,61 @@
 package message_bus
 
-import "time"
+import (
+	"sync"
+	"time"
+
+	"github.com/scys-devs/lib-go"
+)
 
 type PeriodLimit struct {
-	Phase  int64 `json:"phase,omitempty"`  // 偏移量，默认东8区
-	Period int64 `json:"period,omitempty"` // 周期
-	Limit  int   `json:"limit,omitempty"`  // 限制次数
+	Phase    int64  `json:"phase,omitempty"`    // 偏移量，默认东8区
+	Period   int64  `json:"period,omitempty"`   // 周期
+	Limit    int    `json:"limit,omitempty"`    // 限制次数
+	Location string `json:"location,omitempty"` // 周期对齐的时区，例如 America/New_York；设置后忽略Phase
 }
 
 // seconds秒内限制limit次，seconds=-1:整个周期限制limit次
 func NewPeriodLimit(period int64, limit int) (limiter PeriodLimit) {
-	limiter.Phase = 3600 * 8
+	_, offset := lib.Now().In(lib.DefaultLocation).Zone()
+	limiter.Phase = int64(offset)
 	limiter.Period = period
 	limiter.Limit = limit
 	return
 }
 
+// NewPeriodLimitIn 周期按loc时区对齐，夏令时切换后也是当地的0点；loc需要是IANA名称，FixedZone只能用Phase
+func NewPeriodLimitIn(period int64, limit int, loc *time.Location) PeriodLimit {
+	limiter := NewPeriodLimit(period, limit)
+	limiter.Location = loc.String()
+	return limiter
+}
+
+// 时区名称 -> *time.Location，每条消息都会解析出新的PeriodLimit，按名称只加载一次
+var locations sync.Map
+
+func (limiter PeriodLimit) location() *time.Location {
+	if v, ok := locations.Load(limiter.Location); ok {
+		return v.(*time.Location)
+	}
+	loc, err := time.LoadLocation(limiter.Location)
+	if err != nil {
+		Logger.Errorw("load period location", "location", limiter.Location, "err", err)
+		loc = nil // 无效的名称也缓存，使用Phase
+	}
+	v, _ := locations.LoadOrStore(limiter.Location, loc)
+	return v.(*time.Location)
+}
+
+// 当前周期的开始时间
+func (limiter PeriodLimit) start(now time.Time) int64 {
+	if len(limiter.Location) > 0 {
+		if loc := limiter.location(); loc != nil {
+			return lib.PeriodStart(now, limiter.Period, loc)
+		}
+	}
+	return (now.Unix()+limiter.Phase)/limiter.Period*limiter.Period - limiter.Phase
+}
+
 func NewLimit(limit int) PeriodLimit {
 	return NewPeriodLimit(-1, limit)
 }
@@ -32,7 +72,8 @@ type DO struct {
 	PeriodLimit    PeriodLimit       `json:"period_limit"`              // 时间限制
 	WhiteListLimit bool              `json:"whitelist_limit,omitempty"` // 仅限白名单
 	// 临时字段
-	CanSent bool `json:"-"`
+	CanSent bool   `json:"-"`
+	raw     string // 队列中的原始数据，记录后ack
 	// 实际没作用
 	GmtCreate int64 `db:"gmt_create" json:"gmt_create,omitempty"`
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hibiken/asynq"
 	"github.com/scys-devs/lib-go/conn"
 	"github.com/scys-devs/lib-go/server"
 )
@@ -79,3 +80,42 @@ func TestESDao_CountInPeriod(t *testing.T) {
 
 	fmt.Println(time.Since(now))
 }
+
+func TestAsynqRedisOpt(t *testing.T) {
+	opt := AsynqRedisOpt(conn.RedisConfig{Addrs: []string{"127.0.0.1:6379"}, Password: "pass"}, 4)
+	if o, ok := opt.(asynq.RedisClientOpt); !ok || o.Addr != "127.0.0.1:6379" || o.DB != 4 || o.Password != "pass" {
+		t.Errorf("single node opt = %#v", opt)
+	}
+
+	opt = AsynqRedisOpt(conn.RedisConfig{Addrs: []string{"s1:26379", "s2:26379"}, MasterName: "mymaster"}, 5)
+	if o, ok := opt.(asynq.RedisFailoverClientOpt); !ok || o.MasterName != "mymaster" || len(o.SentinelAddrs) != 2 || o.DB != 5 {
+		t.Errorf("sentinel opt = %#v", opt)
+	}
+
+	opt = AsynqRedisOpt(conn.RedisConfig{Addrs: []string{"c1:6379"}, Cluster: true}, 4)
+	if _, ok := opt.(asynq.RedisClusterClientOpt); !ok {
+		t.Errorf("cluster opt = %#v", opt)
+	}
+}
+
+func TestPeriodLimit_start(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	now := time.Date(2024, 11, 3, 12, 0, 0, 0, ny)
+	limiter := NewPeriodLimitIn(86400, 1, ny)
+	for i := 0; i < 2; i++ { // 第二次从缓存取
+		if got := limiter.start(now); got != time.Date(2024, 11, 3, 0, 0, 0, 0, ny).Unix() {
+			t.Errorf("start = %v", time.Unix(got, 0).In(ny))
+		}
+	}
+	if _, ok := locations.Load("America/New_York"); !ok {
+		t.Error("location not cached")
+	}
+	// 无效的时区按Phase计算
+	limiter.Location = "Invalid/Zone"
+	if got, want := limiter.start(now), (now.Unix()+limiter.Phase)/86400*86400-limiter.Phase; got != want {
+		t.Errorf("invalid location start = %v, want %v", got, want)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/go-redis/redis/v8"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/scys-devs/lib-go"
 	"github.com/scys-devs/lib-go/server"
@@ -51,17 +50,29 @@ func (e *Exec) Process(ctx *server.Context) (err error) {
 		go e.send(c, wg)
 	}
 
-	// 需要外部定义好任务的可重入性
-	server.Scheduler.GetBatch(e.BusName, curr, func(item redis.Z) {
-		var m DO // 解析消息
-		if err := jsoniter.UnmarshalFromString(item.Member.(string), &m); err != nil {
-			Logger.Errorw("parse message failed", "raw", item.Member, "err", err)
-			return
+	// 需要外部定义好任务的可重入性；记录之后才ack，中途退出的消息会重新投递
+	for {
+		items, err := server.Scheduler.Claim(e.BusName, curr, server.QueueBatch)
+		if err != nil {
+			wg.Wait()
+			return err
 		}
-		m.CanSent = e.canSend(m) // 判断是否可以发送
-		wg.Add(1)
-		c <- m
-	})
+		for _, item := range items {
+			var m DO // 解析消息
+			if err := jsoniter.UnmarshalFromString(item.Member, &m); err != nil {
+				Logger.Errorw("parse message failed", "raw", item.Member, "err", err)
+				_ = server.Scheduler.Nack(e.BusName, item.Member, server.QueueRetryDelay)
+				continue
+			}
+			m.raw = item.Member
+			m.CanSent = e.canSend(m) // 判断是否可以发送
+			wg.Add(1)
+			c <- m
+		}
+		if int64(len(items)) < server.QueueBatch {
+			break
+		}
+	}
 	wg.Wait()
 
 	return nil
@@ -70,6 +81,7 @@ func (e *Exec) Process(ctx *server.Context) (err error) {
 func (e *Exec) send(c chan DO, wg *sync.WaitGroup) {
 	for m := range c {
 		if m.UserId == 0 {
+			_ = server.Scheduler.Ack(e.BusName, m.raw)
 			wg.Done()
 			continue // 其实感觉是需要warn一下的
 		}
@@ -84,6 +96,9 @@ func (e *Exec) send(c chan DO, wg *sync.WaitGroup) {
 		}
 		// 记录发送信息
 		id := e.dao.Put(m)
+		if err := server.Scheduler.Ack(e.BusName, m.raw); err != nil {
+			Logger.Errorw("ack message failed", "raw", m.raw, "err", err)
+		}
 		wg.Done()
 
 		Logger.Infow("sent message", "id", id, "sent", m.Sent)
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/scys-devs/lib-go"
+	"github.com/scys-devs/lib-go/conn"
+)
+
+// 告警事件
+const (
+	AlertCrash = "crash" // 执行失败，会在退避后重试
+	AlertStop  = "stop"  // 失败次数超过限制，已经停止执行
+)
+
+type Alert struct {
+	Event    string    `json:"event"`
+	Name     string    `json:"name"`
+	Desc     string    `json:"desc"`
+	UUID     string    `json:"uuid"`
+	Err      string    `json:"err"`
+	Crashed  int       `json:"crashed"`
+	Retry    time.Time `json:"retry"` // 下次重试时间，停止时为空
+	Instance string    `json:"instance"`
+	Time     time.Time `json:"time"`
+}
+
+func (a Alert) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[%v] 任务%v %v(%v)\n", strings.ToUpper(a.Event), map[string]string{AlertCrash: "执行失败", AlertStop: "已停止"}[a.Event], a.Name, a.Desc)
+	if len(conn.ENV) > 0 {
+		fmt.Fprintf(&sb, "环境: %v\n", conn.ENV)
+	}
+	fmt.Fprintf(&sb, "实例: %v\n批次: %v\n失败次数: %v\n", a.Instance, a.UUID, a.Crashed)
+	if !a.Retry.IsZero() {
+		fmt.Fprintf(&sb, "下次重试: %v\n", a.Retry.Format("2006-01-02 15:04:05"))
+	}
+	fmt.Fprintf(&sb, "错误: %v", a.Err)
+	return sb.String()
+}
+
+// Alerter 任务失败或者停止时通知
+type Alerter interface {
+	Alert(ctx context.Context, a Alert) error
+}
+
+// webhook类型
+const (
+	WebhookDingTalk = "dingtalk"
+	WebhookFeishu   = "feishu"
+	WebhookSlack    = "slack"
+)
+
+// WebhookAlerter 通过群机器人发送文本消息
+type WebhookAlerter struct {
+	URL  string
+	Kind string // dingtalk / feishu / slack，默认dingtalk
+}
+
+func (w WebhookAlerter) body(text string) any {
+	switch w.Kind {
+	case WebhookFeishu:
+		return map[string]any{"msg_type": "text", "content": map[string]string{"text": text}}
+	case WebhookSlack:
+		return map[string]string{"text": text}
+	default:
+		return map[string]any{"msgtype": "text", "text": map[string]string{"content": text}}
+	}
+}
+
+func (w WebhookAlerter) Alert(ctx context.Context, a Alert) error {
+	b, err := jsoniter.Marshal(w.body(a.String()))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rb, err := lib.DefaultClient.DoRequest(req)
+	if err != nil || w.Kind == WebhookSlack { // slack 返回纯文本 ok
+		return err
+	}
+	// 钉钉返回errcode，飞书返回code，失败时http状态码仍然是200
+	var resp struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+		Code    int    `json:"code"`
+		Msg     string `json:"msg"`
+	}
+	if err = jsoniter.Unmarshal(rb, &resp); err != nil {
+		return fmt.Errorf("webhook response %s", rb)
+	}
+	if resp.ErrCode != 0 || resp.Code != 0 {
+		return fmt.Errorf("webhook errcode=%v code=%v msg=%v%v", resp.ErrCode, resp.Code, resp.ErrMsg, resp.Msg)
+	}
+	return nil
+}
+
+// AddAlerter 添加告警方式，需要在Start之前调用
+func (s *scheduler) AddAlerter(a ...Alerter) {
+	s.alerters = append(s.alerters, a...)
+}
+
+// 异步发送，不阻塞任务执行
+func (s *scheduler) alert(a Alert) {
+	a.Instance = instanceID
+	a.Time = time.Now()
+	for _, alerter := range s.alerters {
+		go func(alerter Alerter) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := alerter.Alert(ctx, a); err != nil {
+				log.Errorw("send alert", "name", a.Name, "event", a.Event, "err", err)
+			}
+		}(alerter)
+	}
+}
@@ -0,0 +1,402 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/scys-devs/lib-go"
+	"github.com/scys-devs/lib-go/conn"
+)
+
+// Backfill 补数据配置，按Step把[Start, End]切成时间点，每个时间点执行一次Process
+type Backfill struct {
+	Name         string        // 执行器名字
+	Start        time.Time     // 第一个时间点
+	End          time.Time     // 最后一个时间点，包含
+	Step         time.Duration // 默认按天；time.Hour按小时
+	Parallel     int           // 同时补几个时间点，默认1；大于1时执行器需要实现ExecutorParallelBackfill
+	Retry        int           // 每个时间点失败后的重试次数
+	RetryBackoff time.Duration // 首次重试的等待时间，之后翻倍，默认10s
+	Checkpoint   string        // 进度文件，为空时存redis，"-" 不保存进度
+	Reset        bool          // 忽略已有进度重新开始
+}
+
+// ExecutorParallelBackfill 返回true表示Process只通过Context.Now()获取时间，可以并发补数据；
+// 并发时不会替换全局时钟，使用lib.Now()或者Scheduler.Now()的执行器会重复处理今天的数据
+type ExecutorParallelBackfill interface {
+	ParallelBackfill() bool
+}
+
+func parallelBackfill(ex Executor) bool {
+	e, ok := ex.(ExecutorParallelBackfill)
+	return ok && e.ParallelBackfill()
+}
+
+// BackfillFailure 重试后仍然失败的时间点
+type BackfillFailure struct {
+	At  time.Time
+	Err string
+}
+
+// BackfillReport 补数据结果
+type BackfillReport struct {
+	Name     string
+	Total    int // 时间点数量
+	Done     int // 本次完成
+	Skipped  int // 之前已经完成，从进度中恢复
+	Failures []BackfillFailure
+	Used     time.Duration
+}
+
+func (r BackfillReport) String() string {
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "backfill %v: total=%d done=%d skipped=%d failed=%d used=%v",
+		r.Name, r.Total, r.Done, r.Skipped, len(r.Failures), r.Used.Round(time.Millisecond))
+	for _, f := range r.Failures {
+		fmt.Fprintf(b, "\n  %v %v", f.At.Format("2006-01-02 15:04"), f.Err)
+	}
+	return b.String()
+}
+
+func (b Backfill) step() time.Duration {
+	if b.Step <= 0 {
+		return 24 * time.Hour
+	}
+	return b.Step
+}
+
+// 按天的步长使用AddDate，夏令时也保持同一个本地时间
+func (b Backfill) slots() (ll []time.Time) {
+	step := b.step()
+	for i := 0; ; i++ {
+		var t time.Time
+		if step%(24*time.Hour) == 0 {
+			t = b.Start.AddDate(0, 0, i*int(step/(24*time.Hour)))
+		} else {
+			t = b.Start.Add(time.Duration(i) * step)
+		}
+		if t.After(b.End) {
+			return
+		}
+		ll = append(ll, t)
+	}
+}
+
+// Backfill 补数据，ctx取消后不再开始新的时间点，已完成的记录在进度里，下次调用会跳过
+func (s *scheduler) Backfill(ctx context.Context, b Backfill) (report BackfillReport, err error) {
+	begin := time.Now()
+	report.Name = b.Name
+	ex := s.executor(b.Name)
+	if ex == nil {
+		return report, fmt.Errorf("executor %v not found", b.Name)
+	}
+	if b.Parallel > 1 && !parallelBackfill(ex) {
+		return report, fmt.Errorf("executor %v does not support parallel backfill, implement ExecutorParallelBackfill or set PARALLEL=1", b.Name)
+	}
+	slots := b.slots()
+	report.Total = len(slots)
+
+	cp := s.checkpoint(b)
+	if b.Reset {
+		if err = cp.clear(); err != nil {
+			return report, err
+		}
+	}
+	done, err := cp.load()
+	if err != nil {
+		return report, err
+	}
+
+	parallel := lib.Max(b.Parallel, 1)
+	jobs := make(chan time.Time)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for at := range jobs {
+				err := s.backfillSlot(ctx, ex, b, at, parallel == 1)
+				mu.Lock()
+				if err == nil {
+					report.Done++
+					if e := cp.mark(at); e != nil {
+						log.Errorw("backfill checkpoint", "name", b.Name, "at", at, "err", e)
+					}
+				} else {
+					report.Failures = append(report.Failures, BackfillFailure{At: at, Err: err.Error()})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+dispatch:
+	for _, at := range slots {
+		if done[at.Unix()] {
+			report.Skipped++
+			continue
+		}
+		select {
+		case jobs <- at:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	sort.Slice(report.Failures, func(i, j int) bool { return report.Failures[i].At.Before(report.Failures[j].At) })
+	report.Used = time.Since(begin)
+	switch {
+	case ctx.Err() != nil:
+		err = ctx.Err()
+	case len(report.Failures) > 0:
+		err = fmt.Errorf("backfill %v: %d of %d failed", b.Name, len(report.Failures), report.Total)
+	case report.Done+report.Skipped == report.Total:
+		err = cp.clear() // 全部完成，下次重新补
+	}
+	log.Infow("backfill complete", "name", b.Name, "total", report.Total, "done", report.Done,
+		"skipped", report.Skipped, "failed", len(report.Failures), "used", report.Used.String(), "err", err)
+	return report, err
+}
+
+// 执行一个时间点，失败后按退避重试；串行时替换全局时钟，兼容使用lib.Now()的执行器
+func (s *scheduler) backfillSlot(ctx context.Context, ex Executor, b Backfill, at time.Time, global bool) (err error) {
+	backoff := b.RetryBackoff
+	if backoff <= 0 {
+		backoff = 10 * time.Second
+	}
+	policy := RestartPolicy{Backoff: backoff, MaxBackoff: 5 * time.Minute, Jitter: 0.2}
+	for attempt := 0; ; attempt++ {
+		c := NewSchedulerContext(ex.Name())
+		c.Context, c.now = ctx, at
+		c.Logger = c.Logger.With("backfill", at.Format("2006-01-02 15:04"))
+		err = func() (err error) {
+			if global {
+				defer lib.SetClock(lib.FixedClock(at))()
+			}
+			if timeout := executorTimeout(ex); timeout > 0 {
+				var cancel context.CancelFunc
+				c.Context, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			defer func() {
+				if r := recover(); r != nil {
+					c.Logger.Errorw("backfill panic", "panic", r, "stack", string(debug.Stack()))
+					err = fmt.Errorf("panic: %v", r)
+				}
+			}()
+			return ex.Process(c)
+		}()
+		if err == nil || attempt >= b.Retry || ctx.Err() != nil {
+			if err != nil {
+				c.Logger.Errorw("backfill failed", "attempt", attempt+1, "err", err)
+			}
+			return err
+		}
+		wait := policy.delay(attempt + 1)
+		c.Logger.Warnw("backfill retry", "attempt", attempt+1, "wait", wait.String(), "err", err)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// backfillCheckpoint 已完成的时间点
+type backfillCheckpoint interface {
+	load() (map[int64]bool, error)
+	mark(at time.Time) error
+	clear() error
+}
+
+func (s *scheduler) checkpoint(b Backfill) backfillCheckpoint {
+	switch {
+	case b.Checkpoint == "-":
+		return noCheckpoint{}
+	case len(b.Checkpoint) > 0:
+		return fileCheckpoint(b.Checkpoint)
+	case s.queue != nil:
+		key := fmt.Sprintf("%v:backfill:%v:%v-%v-%v", s.prefix, b.Name, b.Start.Unix(), b.End.Unix(), int64(b.step()/time.Second))
+		return &redisCheckpoint{s: s, key: key}
+	}
+	return noCheckpoint{}
+}
+
+type noCheckpoint struct{}
+
+func (noCheckpoint) load() (map[int64]bool, error) { return nil, nil }
+func (noCheckpoint) mark(time.Time) error          { return nil }
+func (noCheckpoint) clear() error                  { return nil }
+
+// fileCheckpoint 每完成一个时间点追加一行unix时间，中途被杀也不会损坏
+type fileCheckpoint string
+
+func (f fileCheckpoint) load() (map[int64]bool, error) {
+	file, err := os.Open(string(f))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	done := make(map[int64]bool)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if at, err := strconv.ParseInt(strings.TrimSpace(scanner.Text()), 10, 64); err == nil {
+			done[at] = true
+		}
+	}
+	return done, scanner.Err()
+}
+
+func (f fileCheckpoint) mark(at time.Time) error {
+	file, err := os.OpenFile(string(f), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintln(file, at.Unix()); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (f fileCheckpoint) clear() error {
+	if err := os.Remove(string(f)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// redisCheckpoint 已完成的时间点存在set里，7天后过期
+type redisCheckpoint struct {
+	s   *scheduler
+	key string
+}
+
+func (r *redisCheckpoint) load() (map[int64]bool, error) {
+	members, err := r.s.queue.SMembers(context.Background(), r.key).Result()
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[int64]bool)
+	for _, m := range members {
+		done[lib.StrToInt64(m)] = true
+	}
+	return done, nil
+}
+
+func (r *redisCheckpoint) mark(at time.Time) error {
+	pipe := r.s.queue.TxPipeline()
+	pipe.SAdd(context.Background(), r.key, at.Unix())
+	pipe.Expire(context.Background(), r.key, 7*24*time.Hour)
+	_, err := pipe.Exec(context.Background())
+	return err
+}
+
+func (r *redisCheckpoint) clear() error {
+	return r.s.queue.Del(context.Background(), r.key).Err()
+}
+
+// 和原来一样Start时检查DAEMON环境变量，补完数据后退出进程
+func (s *scheduler) runForCMD() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	report, ok, err := s.BackfillFromEnv(ctx)
+	stop()
+	if !ok {
+		return
+	}
+	fmt.Println(report)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1) // 让调用的脚本知道失败了
+	}
+	os.Exit(0)
+}
+
+// BackfillFromEnv 从命令行临时补数据，没有DAEMON环境变量时返回ok=false
+//
+// 格式 DAEMON=executor [START=20060102|2006010215] [END=...] [STEP=day|hour] [PARALLEL=1] [RETRY=0]
+// [CHECKPOINT=文件路径|-] [RESET=1] [SLEEP=10]；START带小时时默认按小时补
+func (s *scheduler) BackfillFromEnv(ctx context.Context) (report BackfillReport, ok bool, err error) {
+	name := os.Getenv("DAEMON")
+	if len(name) == 0 {
+		return report, false, nil
+	}
+	if s.queue == nil {
+		s.queue = conn.GetRedis()
+	}
+	b, err := s.backfillFromEnv(name, os.Getenv)
+	if err != nil {
+		return report, true, err
+	}
+	if sleep := lib.StrToInt64(os.Getenv("SLEEP")); sleep > 0 {
+		select {
+		case <-time.After(time.Duration(sleep) * time.Second):
+		case <-ctx.Done():
+			return report, true, ctx.Err()
+		}
+	}
+	report, err = s.Backfill(ctx, b)
+	return report, true, err
+}
+
+func (s *scheduler) backfillFromEnv(name string, getenv func(string) string) (b Backfill, err error) {
+	b = Backfill{
+		Name:       name,
+		Parallel:   int(lib.StrToInt64(getenv("PARALLEL"))),
+		Retry:      int(lib.StrToInt64(getenv("RETRY"))),
+		Checkpoint: getenv("CHECKPOINT"),
+		Reset:      len(getenv("RESET")) > 0,
+	}
+	start, end := getenv("START"), getenv("END")
+	hourly := len(start) == len("2006010215")
+	switch getenv("STEP") {
+	case "hour":
+		b.Step = time.Hour
+	case "day":
+		b.Step = 24 * time.Hour
+	case "":
+		if hourly {
+			b.Step = time.Hour
+		}
+	default:
+		return b, fmt.Errorf("invalid STEP %q", getenv("STEP"))
+	}
+	loc := s.Location()
+	parse := func(v string) (time.Time, error) {
+		layout := "20060102"
+		if len(v) == len("2006010215") {
+			layout = "2006010215"
+		}
+		return time.ParseInLocation(layout, v, loc)
+	}
+	if len(start) == 0 {
+		b.Start = lib.StartOfDay(lib.Now(), loc)
+	} else if b.Start, err = parse(start); err != nil {
+		return b, fmt.Errorf("invalid START: %w", err)
+	}
+	if len(end) == 0 {
+		b.End = b.Start
+	} else if b.End, err = parse(end); err != nil {
+		return b, fmt.Errorf("invalid END: %w", err)
+	}
+	if b.End.Before(b.Start) {
+		return b, fmt.Errorf("END %v before START %v", end, start)
+	}
+	return b, nil
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+type backfillExecutor struct {
+	mu   sync.Mutex
+	seen map[string]int
+	fail map[string]int // 前几次失败
+}
+
+func (e *backfillExecutor) Name() string           { return "backfill" }
+func (e *backfillExecutor) Desc() string           { return "" }
+func (e *backfillExecutor) NextDuration() int64    { return -1 }
+func (e *backfillExecutor) Processing() string     { return "" }
+func (e *backfillExecutor) ParallelBackfill() bool { return true }
+func (e *backfillExecutor) Process(c *Context) error {
+	day := c.Now().Format("2006010215")
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.seen[day]++
+	if e.seen[day] <= e.fail[day] {
+		return errors.New("failed " + day)
+	}
+	return nil
+}
+
+func newBackfillScheduler(ex Executor) *scheduler {
+	return &scheduler{executorList: []Executor{ex}, executorStatus: map[string]*ExecutorStatus{}, leases: map[string]*lease{}}
+}
+
+func TestBackfill_slots(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	start := time.Date(2024, 9, 1, 0, 0, 0, 0, loc)
+	if n := len((Backfill{Start: start, End: start.AddDate(0, 0, 6)}).slots()); n != 7 {
+		t.Errorf("daily slots = %v", n)
+	}
+	if n := len((Backfill{Start: start, End: start.Add(23 * time.Hour), Step: time.Hour}).slots()); n != 24 {
+		t.Errorf("hourly slots = %v", n)
+	}
+}
+
+func TestBackfill_resume(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	ex := &backfillExecutor{seen: map[string]int{}, fail: map[string]int{"2024090300": 2, "2024090500": 1}}
+	s := newBackfillScheduler(ex)
+	b := Backfill{
+		Name:       "backfill",
+		Start:      time.Date(2024, 9, 1, 0, 0, 0, 0, loc),
+		End:        time.Date(2024, 9, 6, 0, 0, 0, 0, loc),
+		Parallel:   3,
+		Retry:      1,
+		Checkpoint: filepath.Join(t.TempDir(), "backfill.checkpoint"),
+	}
+	b.RetryBackoff = time.Millisecond
+
+	report, err := s.Backfill(context.Background(), b)
+	if err == nil || report.Total != 6 || report.Done != 5 || len(report.Failures) != 1 {
+		t.Fatalf("first run = %v, %v", report, err)
+	}
+	if !report.Failures[0].At.Equal(time.Date(2024, 9, 3, 0, 0, 0, 0, loc)) {
+		t.Errorf("failure = %+v", report.Failures[0])
+	}
+
+	// 重新执行只补失败的
+	report, err = s.Backfill(context.Background(), b)
+	if err != nil || report.Done != 1 || report.Skipped != 5 {
+		t.Fatalf("resume = %v, %v", report, err)
+	}
+	if ex.seen["2024090100"] != 1 || ex.seen["2024090300"] != 3 {
+		t.Errorf("runs = %v", ex.seen)
+	}
+	// 全部完成后清空进度
+	report, _ = s.Backfill(context.Background(), b)
+	if report.Skipped != 0 || report.Done != 6 {
+		t.Errorf("after complete = %v", report)
+	}
+}
+
+func TestBackfill_cancel(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	s := newBackfillScheduler(&backfillExecutor{seen: map[string]int{}})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	day := time.Date(2024, 9, 1, 0, 0, 0, 0, loc)
+	report, err := s.Backfill(ctx, Backfill{Name: "backfill", Start: day, End: day.AddDate(0, 0, 3), Checkpoint: "-"})
+	if !errors.Is(err, context.Canceled) || report.Done > 1 {
+		t.Errorf("cancelled = %v, %v", report, err)
+	}
+	if _, err = s.Backfill(context.Background(), Backfill{Name: "missing"}); err == nil {
+		t.Error("missing executor accepted")
+	}
+	// 没有声明只使用Context.Now()的执行器不能并发
+	s = newBackfillScheduler(&retryExecutor{runs: make(chan error, 4)})
+	if _, err = s.Backfill(context.Background(), Backfill{Name: "retry", Start: day, End: day, Parallel: 2, Checkpoint: "-"}); err == nil {
+		t.Error("parallel backfill accepted without opt-in")
+	}
+}
+
+func TestScheduler_backfillFromEnv(t *testing.T) {
+	s := newBackfillScheduler(nil)
+	s.location = time.FixedZone("CST", 8*3600)
+	env := func(m map[string]string) func(string) string {
+		return func(k string) string { return m[k] }
+	}
+	b, err := s.backfillFromEnv("job", env(map[string]string{"START": "2024090108", "END": "2024090120", "PARALLEL": "4"}))
+	if err != nil || b.Step != time.Hour || b.Parallel != 4 || len(b.slots()) != 13 {
+		t.Errorf("hourly = %+v, %v", b, err)
+	}
+	b, err = s.backfillFromEnv("job", env(map[string]string{"START": "20240901", "END": "20240930", "RETRY": "2"}))
+	if err != nil || b.step() != 24*time.Hour || b.Retry != 2 || len(b.slots()) != 30 {
+		t.Errorf("daily = %+v, %v", b, err)
+	}
+	if _, err = s.backfillFromEnv("job", env(map[string]string{"START": "20240930", "END": "20240901"})); err == nil {
+		t.Error("END before START accepted")
+	}
+	if _, err = s.backfillFromEnv("job", env(map[string]string{"STEP": "week"})); err == nil {
+		t.Error("invalid STEP accepted")
+	}
+}
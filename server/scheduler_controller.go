@@ -0,0 +1,168 @@
+package server
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+	"github.com/scys-devs/lib-go"
+	"github.com/scys-devs/lib-go/server/dash"
+)
+
+// SchedulerController 任务管理接口，返回格式适配amis；鉴权由注册时的路由组负责
+type SchedulerController struct {
+	LogFile string // 任务日志文件，默认 log/scheduler.log
+}
+
+type schedulerReq struct {
+	Name   string `form:"name" json:"name" binding:"required"`
+	Status bool   `form:"status" json:"status"`
+	Limit  int    `form:"limit" json:"limit"`
+}
+
+func (ctl SchedulerController) Register(e *gin.RouterGroup) {
+	g := e.Group("/scheduler")
+	g.GET("/list", ctl.list)
+	g.POST("/once", ctl.once)
+	g.POST("/status", ctl.status)
+	g.POST("/resume", ctl.resume)
+	g.GET("/history", ctl.history)
+	g.GET("/log", ctl.log)
+}
+
+func (SchedulerController) list(c *gin.Context) {
+	ll := Scheduler.GetAll()
+	SendOK(c, dash.TableDTO{Items: ll, Total: len(ll)})
+}
+
+func (SchedulerController) once(c *gin.Context) {
+	var req schedulerReq
+	if err := c.ShouldBind(&req); err != nil {
+		SendErr(c, &E{Code: -1, Message: "参数错误"})
+		return
+	}
+	if !Scheduler.Once(req.Name) {
+		SendErr(c, &E{Code: -1, Message: "任务不存在或正在执行，请稍后再试"})
+		return
+	}
+	SendOK(c, nil)
+}
+
+func (SchedulerController) status(c *gin.Context) {
+	var req schedulerReq
+	if err := c.ShouldBind(&req); err != nil {
+		SendErr(c, &E{Code: -1, Message: "参数错误"})
+		return
+	}
+	if !Scheduler.SetStatus(req.Name, req.Status) {
+		SendErr(c, &E{Code: -1, Message: "任务不存在"})
+		return
+	}
+	SendOK(c, nil)
+}
+
+func (SchedulerController) resume(c *gin.Context) {
+	var req schedulerReq
+	if err := c.ShouldBind(&req); err != nil {
+		SendErr(c, &E{Code: -1, Message: "参数错误"})
+		return
+	}
+	if !Scheduler.Resume(req.Name) {
+		SendErr(c, &E{Code: -1, Message: "任务不存在"})
+		return
+	}
+	SendOK(c, nil)
+}
+
+// 执行记录，extra中附带统计信息
+func (SchedulerController) history(c *gin.Context) {
+	var req schedulerReq
+	if err := c.ShouldBind(&req); err != nil {
+		SendErr(c, &E{Code: -1, Message: "参数错误"})
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 100
+	}
+	ll, err := Scheduler.History(req.Name, req.Limit)
+	if err != nil {
+		SendErr(c, err)
+		return
+	}
+	SendOK(c, dash.TableDTO{Items: ll, Total: len(ll), Extra: ComputeStats(req.Name, ll)})
+}
+
+// 查看最近一次执行的日志
+func (ctl SchedulerController) log(c *gin.Context) {
+	var req schedulerReq
+	if err := c.ShouldBind(&req); err != nil {
+		SendErr(c, &E{Code: -1, Message: "参数错误"})
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 200
+	}
+	uid := Scheduler.LastUUID(req.Name)
+	if len(uid) == 0 {
+		SendErr(c, &E{Code: -1, Message: "任务还没有执行过"})
+		return
+	}
+
+	lines, err := tailLog(ctl.logFile(), uid, req.Limit)
+	if err != nil {
+		SendErr(c, err)
+		return
+	}
+	SendOK(c, gin.H{"uuid": uid, "lines": lines})
+}
+
+func (ctl SchedulerController) logFile() string {
+	if len(ctl.LogFile) > 0 {
+		return ctl.LogFile
+	}
+	wd, _ := os.Getwd()
+	return filepath.Join(wd, "log", "scheduler.log")
+}
+
+// 每次从文件末尾往前读取的大小
+var tailChunk int64 = 64 * 1024
+
+// 获取包含keyword的最后limit行，从文件末尾往前读，找够了就停止
+func tailLog(file, keyword string, limit int) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	kw := []byte(keyword)
+	lines := make([]string, 0, limit)
+	var rest []byte // 上一块开头不完整的行
+	for pos := info.Size(); pos > 0 && len(lines) < limit; {
+		n := lib.Min(tailChunk, pos)
+		pos -= n
+		chunk := make([]byte, n, n+int64(len(rest)))
+		if _, err = f.ReadAt(chunk, pos); err != nil {
+			return nil, err
+		}
+		parts := bytes.Split(append(chunk, rest...), []byte{'\n'})
+		rest = parts[0]
+		if pos == 0 { // 文件的第一行
+			rest, parts = nil, append([][]byte{nil}, parts...)
+		}
+		for i := len(parts) - 1; i > 0 && len(lines) < limit; i-- {
+			if bytes.Contains(parts[i], kw) {
+				lines = append(lines, string(parts[i]))
+			}
+		}
+	}
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return lines, nil
+}
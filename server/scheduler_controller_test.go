@@ -0,0 +1,34 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTailLog(t *testing.T) {
+	defer func(n int64) { tailChunk = n }(tailChunk)
+	tailChunk = 7 // 小于一行，覆盖跨块的行
+
+	var b strings.Builder
+	for i := 0; i < 20; i++ {
+		fmt.Fprintf(&b, "line %02d uid-%d\n", i, i%2)
+	}
+	file := filepath.Join(t.TempDir(), "scheduler.log")
+	if err := os.WriteFile(file, []byte(b.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := tailLog(file, "uid-1", 3); !reflect.DeepEqual(got, []string{"line 15 uid-1", "line 17 uid-1", "line 19 uid-1"}) {
+		t.Errorf("tail = %q", got)
+	}
+	if got, _ := tailLog(file, "line 00", 3); !reflect.DeepEqual(got, []string{"line 00 uid-0"}) {
+		t.Errorf("first line = %q", got)
+	}
+	if got, _ := tailLog(file, "none", 3); len(got) != 0 {
+		t.Errorf("none = %q", got)
+	}
+}
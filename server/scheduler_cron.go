@@ -0,0 +1,152 @@
+package server
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/scys-devs/lib-go"
+)
+
+var cronParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// CronShiftLookback 顺延模式下往前查找多少天内被顺延、还没执行的时间，重启后不会丢
+var CronShiftLookback = 31
+
+// CronSearchYears 往后查找多少年，至少要覆盖2月29日这种闰年才有的日期
+var CronSearchYears = 8
+
+// Cron 根据cron表达式计算NextDuration，可以嵌入到执行器里，也可以用WithCron包装已有的执行器
+type Cron struct {
+	Spec     string                 // 5位或6位(带秒)表达式，支持 CRON_TZ=Asia/Shanghai 前缀和 @daily 等写法
+	Location *time.Location         // 表达式所在时区，默认Scheduler.Location()；表达式带CRON_TZ时以CRON_TZ为准
+	Jitter   time.Duration          // 随机延后，避免多个任务同时启动
+	Calendar func(t time.Time) bool // 返回false的日期跳过，例如lib.InWorkWeek、lib.Holidays.IsWorkday
+	Shift    bool                   // Calendar返回false时不跳过，顺延到下一个允许的日期，时间不变
+
+	once     sync.Once
+	schedule cron.Schedule
+	err      error
+}
+
+// Next 获取now之后的下次执行时间，表达式非法或者CronSearchYears年内没有可执行的日期时返回零值
+//
+// 按天遍历，每天只取第一次和now之后的第一次触发，秒级的表达式也不会逐个遍历
+func (c *Cron) Next(now time.Time) time.Time {
+	c.once.Do(func() {
+		c.schedule, c.err = cronParser.Parse(c.Spec)
+		if c.err != nil {
+			log.Errorw("parse cron", "spec", c.Spec, "err", c.err)
+		}
+	})
+	if c.err != nil {
+		return time.Time{}
+	}
+
+	// 按天遍历、Calendar和sameDay都使用同一个时区
+	loc := c.Location
+	if spec, ok := c.schedule.(*cron.SpecSchedule); ok && spec.Location != time.Local {
+		loc = spec.Location
+	}
+	if loc == nil {
+		loc = Scheduler.Location()
+	}
+	now = now.In(loc)
+	shift := c.Shift && c.Calendar != nil
+	day := startOfDay(now)
+	if shift {
+		day = day.AddDate(0, 0, -CronShiftLookback)
+	}
+	var best time.Time
+	for limit := now.AddDate(CronSearchYears, 0, 0); day.Before(limit); day = day.AddDate(0, 0, 1) {
+		if !best.IsZero() && !day.Before(best) {
+			break
+		}
+		first := c.schedule.Next(day.Add(-time.Nanosecond))
+		if first.IsZero() || first.After(limit) {
+			break
+		}
+		if !sameDay(first, day) { // 跳过没有触发的日期
+			day = startOfDay(first)
+		}
+		// 当天now之后的第一次触发，顺延时时间不变，所以同样适用于顺延后的日期
+		after := first
+		if !after.After(now) || shift {
+			after = c.schedule.Next(time.Date(day.Year(), day.Month(), day.Day(), now.Hour(), now.Minute(), now.Second(), now.Nanosecond(), loc))
+		}
+
+		var at time.Time
+		switch {
+		case c.Calendar == nil || c.Calendar(first):
+			at = first
+			if !at.After(now) && sameDay(after, day) {
+				at = after
+			}
+		case shift:
+			if at = c.shift(first); !at.After(now) && sameDay(after, day) {
+				at = c.shift(after)
+			}
+		}
+		if at.After(now) && (best.IsZero() || at.Before(best)) {
+			best = at
+		}
+	}
+	return best
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// 顺延到下一个允许的日期，一年内都没有时返回零值
+func (c *Cron) shift(t time.Time) time.Time {
+	y, m, d := t.Date()
+	h, mi, s := t.Clock()
+	for i := 1; i <= 366; i++ {
+		day := time.Date(y, m, d+i, h, mi, s, 0, t.Location())
+		if c.Calendar(day) {
+			return day
+		}
+	}
+	return time.Time{}
+}
+
+// NextDuration 距离下次执行的秒数，无法执行时返回-1让执行器停止
+func (c *Cron) NextDuration() int64 {
+	now := Scheduler.Now()
+	next := c.Next(now)
+	if next.IsZero() {
+		return -1
+	}
+	d := next.Sub(now)
+	if c.Jitter > 0 {
+		d += time.Duration(rand.Int63n(int64(c.Jitter)))
+	}
+	return lib.CeilSeconds(d) // 向上取整，提前醒来会重复执行同一次；0 表示连续执行，至少等一秒
+}
+
+type cronExecutor struct {
+	Executor
+	cron *Cron
+}
+
+func (e *cronExecutor) NextDuration() int64 {
+	return e.cron.NextDuration()
+}
+
+func (e *cronExecutor) RunEverywhere() bool {
+	return runEverywhere(e.Executor)
+}
+
+// WithCron 使用cron表达式替换执行器自己的NextDuration
+func WithCron(ex Executor, c *Cron) Executor {
+	return &cronExecutor{Executor: ex, cron: c}
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scys-devs/lib-go"
+)
+
+func TestCron_Next(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	now := time.Date(2024, 9, 27, 10, 0, 0, 0, loc) // 周五
+
+	cases := []struct {
+		cron *Cron
+		want time.Time
+	}{
+		{&Cron{Spec: "30 9 * * 1-5", Location: loc}, time.Date(2024, 9, 30, 9, 30, 0, 0, loc)},
+		{&Cron{Spec: "0 0 0 1 * *", Location: loc}, time.Date(2024, 10, 1, 0, 0, 0, 0, loc)},
+		{&Cron{Spec: "@daily", Location: loc}, time.Date(2024, 9, 28, 0, 0, 0, 0, loc)},
+		{&Cron{Spec: "30 9 * * *", Location: loc, Calendar: lib.InWorkWeek}, time.Date(2024, 9, 30, 9, 30, 0, 0, loc)},
+		{&Cron{Spec: "30 9 * * *", Location: loc, Calendar: lib.NewHolidayCalendar("2024-09-30").IsWorkday}, time.Date(2024, 10, 1, 9, 30, 0, 0, loc)},
+		{&Cron{Spec: "0 9 1 * *", Location: loc, Calendar: lib.NewHolidayCalendar("2024-10-01").IsWorkday}, time.Date(2024, 11, 1, 9, 0, 0, 0, loc)},
+		{&Cron{Spec: "0 9 1 * *", Location: loc, Calendar: lib.NewHolidayCalendar("2024-10-01").IsWorkday, Shift: true}, time.Date(2024, 10, 2, 9, 0, 0, 0, loc)},
+		{&Cron{Spec: "0 9 27 * *", Location: loc, Calendar: lib.NewHolidayCalendar("2024-09-27").IsWorkday, Shift: true}, time.Date(2024, 9, 30, 9, 0, 0, 0, loc)}, // 已经过去但被顺延的
+		{&Cron{Spec: "0 0 8 * * *", Location: loc, Calendar: lib.NewHolidayCalendar("2024-09-27").IsWorkday, Shift: true}, time.Date(2024, 9, 30, 8, 0, 0, 0, loc)},
+		{&Cron{Spec: "*/10 * * * * *", Location: loc, Calendar: lib.NewHolidayCalendar("2024-09-27").IsWorkday, Shift: true}, time.Date(2024, 9, 30, 0, 0, 0, 0, loc)},
+		{&Cron{Spec: "*/10 * * * * *", Location: loc, Calendar: lib.InWorkWeek, Shift: true}, time.Date(2024, 9, 27, 10, 0, 10, 0, loc)},
+		{&Cron{Spec: "*/10 * * * * *", Location: loc, Calendar: lib.NewHolidayCalendar("2024-09-27").IsWorkday}, time.Date(2024, 9, 30, 0, 0, 0, 0, loc)},
+		{&Cron{Spec: "0 0 0 29 2 *", Location: loc}, time.Date(2028, 2, 29, 0, 0, 0, 0, loc)},
+		{&Cron{Spec: "0 0 0 30 2 *", Location: loc}, time.Time{}},
+		// 纽约的周五23点是东8区的周六，Calendar按表达式的时区判断
+		{&Cron{Spec: "CRON_TZ=America/New_York 0 23 * * 5", Location: loc, Calendar: lib.InWorkWeek}, time.Date(2024, 9, 28, 11, 0, 0, 0, loc)},
+		{&Cron{Spec: "invalid"}, time.Time{}},
+	}
+	for _, c := range cases {
+		if got := c.cron.Next(now); !got.Equal(c.want) {
+			t.Errorf("%v next = %v, want %v", c.cron.Spec, got, c.want)
+		}
+	}
+}
+
+func TestCron_NextDuration(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	defer lib.SetClock(lib.FixedClock(time.Date(2024, 9, 27, 10, 0, 0, 500e6, loc)))()
+
+	c := &Cron{Spec: "0 * * * * *", Location: loc}
+	if d := c.NextDuration(); d != 60 { // 59.5s向上取整，不能提前醒来
+		t.Errorf("next duration = %v, want 60", d)
+	}
+	c = &Cron{Spec: "* * * * * *", Location: loc}
+	if d := c.NextDuration(); d != 1 {
+		t.Errorf("next duration = %v, want 1", d)
+	}
+}
@@ -0,0 +1,280 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/scys-devs/lib-go"
+	"github.com/scys-devs/lib-go/conn"
+)
+
+// ExecutorRun 一次执行记录，时间单位毫秒
+type ExecutorRun struct {
+	Executor   string `json:"executor" db:"executor"`
+	UUID       string `json:"uuid" db:"uuid"`
+	Start      int64  `json:"start" db:"gmt_start"`
+	End        int64  `json:"end" db:"gmt_end"`
+	Duration   int64  `json:"duration" db:"duration"` // 聚合记录为平均耗时
+	Runs       int    `json:"runs" db:"runs"`         // 轮询任务把一段时间内成功的执行聚合成一条，0和1都表示一次
+	Err        string `json:"err" db:"err"`
+	Panic      string `json:"panic" db:"panic"`           // panic时的堆栈
+	Processing string `json:"processing" db:"processing"` // 执行结束时的进度描述
+}
+
+var (
+	HistoryPollInterval  = time.Minute // 轮询任务成功的执行按这个间隔聚合成一条记录
+	HistoryPruneInterval = time.Hour   // 定期清理过期记录的间隔
+)
+
+// HistoryStore 执行记录的存储，List按开始时间倒序
+type HistoryStore interface {
+	Save(run ExecutorRun) error
+	List(name string, limit int) ([]ExecutorRun, error)
+}
+
+// HistoryPruner 需要定期清理过期记录的存储，调度器运行期间每HistoryPruneInterval调用一次
+type HistoryPruner interface {
+	Prune() error
+}
+
+func (run ExecutorRun) runs() int {
+	return lib.Max(run.Runs, 1)
+}
+
+// RedisHistory 每个执行器一个list，保留最近Retention条
+type RedisHistory struct {
+	Retention int           // 默认500条
+	Expire    time.Duration // 默认30天
+	queue     redis.UniversalClient
+	prefix    string
+}
+
+func NewRedisHistory(retention int, expire time.Duration) *RedisHistory {
+	return &RedisHistory{Retention: retention, Expire: expire, queue: conn.GetRedis(), prefix: conn.PREFIX}
+}
+
+func (h *RedisHistory) key(name string) string {
+	return fmt.Sprintf("%v:history:%v", h.prefix, name)
+}
+
+func (h *RedisHistory) Save(run ExecutorRun) error {
+	retention, expire := h.Retention, h.Expire
+	if retention <= 0 {
+		retention = 500
+	}
+	if expire <= 0 {
+		expire = 30 * 24 * time.Hour
+	}
+	raw, _ := jsoniter.MarshalToString(run)
+	key := h.key(run.Executor)
+	pipe := h.queue.TxPipeline()
+	pipe.LPush(context.Background(), key, raw)
+	pipe.LTrim(context.Background(), key, 0, int64(retention-1))
+	pipe.Expire(context.Background(), key, expire)
+	_, err := pipe.Exec(context.Background())
+	return err
+}
+
+func (h *RedisHistory) List(name string, limit int) (ll []ExecutorRun, err error) {
+	raw, err := h.queue.LRange(context.Background(), h.key(name), 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range raw {
+		var run ExecutorRun
+		if jsoniter.UnmarshalFromString(item, &run) == nil {
+			ll = append(ll, run)
+		}
+	}
+	return
+}
+
+// MysqlHistory 存入scheduler_run表，建表语句见DDL.sql
+type MysqlHistory struct {
+	DB        string        // conn.RegisterMysql注册的名字，默认conn.DefaultDB
+	Retention time.Duration // 保留时间，默认30天
+}
+
+func (h *MysqlHistory) db() *conn.MysqlDB {
+	if len(h.DB) == 0 {
+		return conn.GetMysqlDB()
+	}
+	return conn.DB(h.DB)
+}
+
+func (h *MysqlHistory) Save(run ExecutorRun) error {
+	run.Runs = run.runs()
+	_, err := h.db().NamedExec(`insert into scheduler_run (executor, uuid, gmt_start, gmt_end, duration, runs, err, panic, processing)
+		values (:executor, :uuid, :gmt_start, :gmt_end, :duration, :runs, :err, :panic, :processing)`, run)
+	return err
+}
+
+func (h *MysqlHistory) List(name string, limit int) (ll []ExecutorRun, err error) {
+	err = h.db().Select(&ll, `select executor, uuid, gmt_start, gmt_end, duration, runs, err, panic, processing
+		from scheduler_run where executor=? order by gmt_start desc limit ?`, name, limit)
+	return
+}
+
+// Prune 分批删除超过保留时间的记录，避免长时间锁表
+func (h *MysqlHistory) Prune() error {
+	retention := h.Retention
+	if retention <= 0 {
+		retention = 30 * 24 * time.Hour
+	}
+	before := time.Now().Add(-retention).UnixMilli()
+	for {
+		res, err := h.db().Exec(`delete from scheduler_run where gmt_start<? limit 5000`, before)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n < 5000 {
+			return nil
+		}
+	}
+}
+
+// ExecutorStats 执行统计，时间单位毫秒
+type ExecutorStats struct {
+	Executor    string  `json:"executor"`
+	Total       int     `json:"total"`
+	Success     int     `json:"success"`
+	SuccessRate float64 `json:"success_rate"`
+	Avg         int64   `json:"avg"`
+	P95         int64   `json:"p95"`
+	LastErr     string  `json:"last_err"`
+}
+
+// ComputeStats 根据执行记录计算成功率和耗时分位，聚合记录按次数加权
+func ComputeStats(name string, runs []ExecutorRun) (stats ExecutorStats) {
+	stats.Executor = name
+	if len(runs) == 0 {
+		return
+	}
+	sorted := make([]ExecutorRun, 0, len(runs))
+	var sum int64
+	for _, run := range runs {
+		n := run.runs()
+		stats.Total += n
+		if len(run.Err) == 0 {
+			stats.Success += n
+		} else if len(stats.LastErr) == 0 {
+			stats.LastErr = run.Err
+		}
+		sorted = append(sorted, run)
+		sum += run.Duration * int64(n)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Duration < sorted[j].Duration })
+	stats.SuccessRate = float64(stats.Success) / float64(stats.Total)
+	stats.Avg = sum / int64(stats.Total)
+	rank := (stats.Total*95 + 99) / 100
+	for _, run := range sorted {
+		if rank -= run.runs(); rank <= 0 {
+			stats.P95 = run.Duration
+			break
+		}
+	}
+	return
+}
+
+// SetHistoryStore 替换执行记录的存储，需要在Start之前调用
+func (s *scheduler) SetHistoryStore(store HistoryStore) {
+	s.history = store
+}
+
+// History 获取最近的执行记录
+func (s *scheduler) History(name string, limit int) ([]ExecutorRun, error) {
+	if s.history == nil {
+		return nil, nil
+	}
+	return s.history.List(name, limit)
+}
+
+// Stats 根据最近limit次执行计算统计信息
+func (s *scheduler) Stats(name string, limit int) (ExecutorStats, error) {
+	runs, err := s.History(name, limit)
+	return ComputeStats(name, runs), err
+}
+
+// 定期清理过期的执行记录，Stop时退出
+func (s *scheduler) prune(ctx context.Context, pruner HistoryPruner) {
+	ticker := time.NewTicker(HistoryPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := pruner.Prune(); err != nil {
+				log.Errorw("prune history", "err", err)
+			}
+		}
+	}
+}
+
+// pollStats 轮询任务成功执行的聚合，只在执行协程里访问
+type pollStats struct {
+	uid   string
+	start int64 // 纳秒
+	runs  int
+	sum   int64 // 纳秒
+}
+
+// 轮询任务成功的执行先聚合，每HistoryPollInterval写入一条
+func (s *scheduler) recordPoll(ex Executor, status *ExecutorStatus, uid string, gmtStart int64) {
+	p := &status.poll
+	if p.runs == 0 {
+		p.start = gmtStart
+	}
+	p.uid = uid
+	p.runs++
+	p.sum += time.Now().UnixNano() - gmtStart
+	if time.Duration(time.Now().UnixNano()-p.start) >= HistoryPollInterval {
+		s.flushPoll(ex, status)
+	}
+}
+
+func (s *scheduler) flushPoll(ex Executor, status *ExecutorStatus) {
+	p := status.poll
+	if p.runs == 0 || s.history == nil {
+		return
+	}
+	status.poll = pollStats{}
+	run := ExecutorRun{
+		Executor:   ex.Name(),
+		UUID:       p.uid,
+		Start:      p.start / 1e6,
+		End:        time.Now().UnixMilli(),
+		Duration:   p.sum / int64(p.runs) / 1e6,
+		Runs:       p.runs,
+		Processing: ex.Processing(),
+	}
+	if err := s.history.Save(run); err != nil {
+		log.Errorw("save history", "name", run.Executor, "err", err)
+	}
+}
+
+func (s *scheduler) record(ex Executor, uid string, gmtStart int64, err error, stack string) {
+	if s.history == nil {
+		return
+	}
+	end := time.Now()
+	run := ExecutorRun{
+		Executor:   ex.Name(),
+		UUID:       uid,
+		Start:      gmtStart / 1e6,
+		End:        end.UnixMilli(),
+		Duration:   end.UnixMilli() - gmtStart/1e6,
+		Panic:      stack,
+		Processing: ex.Processing(),
+	}
+	if err != nil {
+		run.Err = err.Error()
+	}
+	if err := s.history.Save(run); err != nil {
+		log.Errorw("save history", "name", run.Executor, "err", err)
+	}
+}
@@ -0,0 +1,39 @@
+package server
+
+import "testing"
+
+func TestComputeStats(t *testing.T) {
+	var runs []ExecutorRun
+	for i := 1; i <= 20; i++ {
+		run := ExecutorRun{Duration: int64(i * 100)}
+		if i%5 == 0 {
+			run.Err = "panic"
+		}
+		runs = append(runs, run)
+	}
+	stats := ComputeStats("daily", runs)
+	if stats.Total != 20 || stats.Success != 16 || stats.SuccessRate != 0.8 {
+		t.Errorf("success stats = %+v", stats)
+	}
+	if stats.P95 != 1900 || stats.Avg != 1050 || stats.LastErr != "panic" {
+		t.Errorf("duration stats = %+v", stats)
+	}
+	if empty := ComputeStats("daily", nil); empty.Total != 0 || empty.P95 != 0 {
+		t.Errorf("empty stats = %+v", empty)
+	}
+}
+
+func TestComputeStats_aggregated(t *testing.T) {
+	runs := []ExecutorRun{
+		{Duration: 10, Runs: 150},
+		{Duration: 2000, Err: "timeout"},
+		{Duration: 20, Runs: 49},
+	}
+	stats := ComputeStats("poll", runs)
+	if stats.Total != 200 || stats.Success != 199 || stats.SuccessRate != 0.995 {
+		t.Errorf("success stats = %+v", stats)
+	}
+	if stats.P95 != 20 || stats.Avg != (1500+2000+980)/200 {
+		t.Errorf("duration stats = %+v", stats)
+	}
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/nacos-group/nacos-sdk-go/v2/inner/uuid"
+)
+
+var LeaseTTL = 30 * time.Second // 持有者宕机后，最长经过这个时间由其他实例接管
+
+// ExecutorEverywhere 返回true的执行器不参与选主，每个实例都会执行，例如维护进程内状态的任务
+type ExecutorEverywhere interface {
+	RunEverywhere() bool
+}
+
+func runEverywhere(ex Executor) bool {
+	e, ok := ex.(ExecutorEverywhere)
+	return ok && e.RunEverywhere()
+}
+
+// 当前实例的唯一标识
+var instanceID = func() string {
+	host, _ := os.Hostname()
+	uid, _ := uuid.NewV4()
+	return fmt.Sprintf("%v:%v", host, uid.String())
+}()
+
+// 抢占成功时递增fencing token；两个key使用相同的hash tag，兼容cluster
+var leaseAcquireScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+	return redis.call('INCR', KEYS[2])
+end
+return 0
+`)
+
+var leaseRenewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+var leaseReleaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// lease 每个执行器一把锁，持有期间不断续期，只有持有者才会执行
+type lease struct {
+	queue redis.UniversalClient
+	key   string
+	fence int64 // 最近一次抢占成功的fencing token，0表示未持有
+	stop  chan struct{}
+}
+
+func newLease(queue redis.UniversalClient, prefix, name string) *lease {
+	return &lease{
+		queue: queue,
+		key:   fmt.Sprintf("{%v:lease:%v}", prefix, name),
+		stop:  make(chan struct{}),
+	}
+}
+
+// Fence 持有锁时返回fencing token，否则返回0
+func (l *lease) Fence() int64 {
+	return atomic.LoadInt64(&l.fence)
+}
+
+func (l *lease) Held() bool {
+	return l.Fence() > 0
+}
+
+func (l *lease) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), LeaseTTL/3)
+	defer cancel()
+	ttl := LeaseTTL.Milliseconds()
+	if l.Held() {
+		ok, err := leaseRenewScript.Run(ctx, l.queue, []string{l.key}, instanceID, ttl).Int64()
+		if err != nil || ok == 0 { // 续期失败视为丢失，由下一轮重新抢占
+			log.Warnw("lease lost", "key", l.key, "err", err)
+			atomic.StoreInt64(&l.fence, 0)
+		}
+		return
+	}
+	fence, err := leaseAcquireScript.Run(ctx, l.queue, []string{l.key, l.key + ":fence"}, instanceID, ttl).Int64()
+	if err != nil && err != redis.Nil {
+		log.Errorw("lease acquire", "key", l.key, "err", err)
+		return
+	}
+	if fence > 0 {
+		log.Infow("lease acquired", "key", l.key, "fence", fence)
+		atomic.StoreInt64(&l.fence, fence)
+	}
+}
+
+// 每1/3个TTL续期或者尝试抢占
+func (l *lease) keep() {
+	l.tick()
+	ticker := time.NewTicker(LeaseTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			l.tick()
+		}
+	}
+}
+
+// 主动释放，其他实例可以立即接管
+func (l *lease) release() {
+	close(l.stop)
+	if l.Held() {
+		atomic.StoreInt64(&l.fence, 0)
+		_ = leaseReleaseScript.Run(context.Background(), l.queue, []string{l.key}, instanceID).Err()
+	}
+}
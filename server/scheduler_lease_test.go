@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestScheduler_exitReleasesLease(t *testing.T) {
+	s := &scheduler{
+		executorStatus: map[string]*ExecutorStatus{"job": {running: true, Leader: true}},
+		leases:         make(map[string]*lease),
+	}
+	l := newLease(nil, "test", "job")
+	s.leases["job"] = l
+	s.wg.Add(1)
+	s.exit("job")
+
+	if _, ok := s.leases["job"]; ok {
+		t.Error("lease kept after exit")
+	}
+	select {
+	case <-l.stop:
+	default:
+		t.Error("lease still renewing after exit")
+	}
+	if st := s.executorStatus["job"]; st.running || st.Leader {
+		t.Errorf("status after exit: %+v", st)
+	}
+}
+
+type blockingExecutor struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (e *blockingExecutor) Name() string        { return "block" }
+func (e *blockingExecutor) Desc() string        { return "" }
+func (e *blockingExecutor) NextDuration() int64 { return 3600 }
+func (e *blockingExecutor) Processing() string  { return "" }
+func (e *blockingExecutor) Process(c *Context) error {
+	close(e.started)
+	<-e.release // 不响应取消
+	return nil
+}
+
+func TestScheduler_stopKeepsLeaseWhileRunning(t *testing.T) {
+	ex := &blockingExecutor{started: make(chan struct{}), release: make(chan struct{})}
+	s := &scheduler{executorStatus: make(map[string]*ExecutorStatus), leases: make(map[string]*lease)}
+	s.Register(ex)
+	s.executorStatus[ex.Name()].Status = true
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.started = true
+	s.launch(ex)
+	if _, ok := s.lease(ex.Name()); ok {
+		t.Fatal("lease created without redis")
+	}
+	s.leases[ex.Name()] = newLease(nil, "test", ex.Name()) // 模拟持有的锁
+	for i := 0; !s.Once(ex.Name()); i++ {
+		if i > 100 {
+			t.Fatal("once not accepted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	<-ex.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s.Stop(ctx); err == nil {
+		t.Fatal("stop returned before process finished")
+	}
+	if _, ok := s.lease(ex.Name()); !ok {
+		t.Error("lease released while process still running")
+	}
+	close(ex.release)
+	s.wg.Wait()
+	if _, ok := s.lease(ex.Name()); ok {
+		t.Error("lease kept after run exited")
+	}
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RestartPolicy 执行失败后的处理方式
+type RestartPolicy struct {
+	Backoff    time.Duration // 首次失败后的等待时间，之后每次翻倍；0表示不等待
+	MaxBackoff time.Duration // 最长等待时间，0表示不限制
+	Jitter     float64       // 随机抖动比例，0.2表示上下浮动20%
+	MaxRetries int           // 窗口内最多失败次数，超过后停止执行；0表示一直重试
+	Window     time.Duration // 统计失败次数的时间窗口，0表示一直累计
+	ResetAfter int           // 连续成功多少次后清空失败次数，0表示不清空
+}
+
+// DefaultRestartPolicy 和旧版本一样失败超过10次后停止，失败之间增加退避
+var DefaultRestartPolicy = RestartPolicy{
+	Backoff:    time.Second,
+	MaxBackoff: 5 * time.Minute,
+	Jitter:     0.2,
+	MaxRetries: 10,
+}
+
+// ExecutorRestart 自定义失败后的处理方式，不实现的使用DefaultRestartPolicy
+type ExecutorRestart interface {
+	RestartPolicy() RestartPolicy
+}
+
+func restartPolicy(ex Executor) RestartPolicy {
+	if e, ok := ex.(ExecutorRestart); ok {
+		return e.RestartPolicy()
+	}
+	return DefaultRestartPolicy
+}
+
+// 第n次连续失败后的等待时间
+func (p RestartPolicy) delay(n int) time.Duration {
+	if p.Backoff <= 0 || n <= 0 {
+		return 0
+	}
+	d := p.Backoff
+	for i := 1; i < n; i++ {
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		d += time.Duration((rand.Float64()*2 - 1) * p.Jitter * float64(d))
+	}
+	return d
+}
+
+// 失败记录，由scheduler.mu保护
+type crashState struct {
+	failures  []time.Time // 窗口内的失败时间
+	streak    int         // 连续失败次数
+	successes int         // 连续成功次数
+}
+
+// 记录一次失败，返回是否需要停止以及下次执行前的等待时间
+func (cs *crashState) fail(p RestartPolicy, now time.Time) (stop bool, wait time.Duration) {
+	cs.successes = 0
+	cs.streak++
+	cs.failures = append(cs.failures, now)
+	if p.Window > 0 {
+		i := 0
+		for i < len(cs.failures) && now.Sub(cs.failures[i]) > p.Window {
+			i++
+		}
+		cs.failures = cs.failures[i:]
+	}
+	if p.MaxRetries > 0 && len(cs.failures) > p.MaxRetries {
+		return true, 0
+	}
+	return false, p.delay(cs.streak)
+}
+
+// 记录一次成功，返回是否清空了失败次数
+func (cs *crashState) succeed(p RestartPolicy) bool {
+	cs.streak = 0
+	cs.successes++
+	if p.ResetAfter > 0 && cs.successes >= p.ResetAfter && len(cs.failures) > 0 {
+		cs.failures = nil
+		return true
+	}
+	return false
+}
+
+func (cs *crashState) reset() {
+	*cs = crashState{}
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRestartPolicy_delay(t *testing.T) {
+	p := RestartPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	for n, want := range []time.Duration{0, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := p.delay(n); got != want {
+			t.Errorf("delay(%v) = %v, want %v", n, got, want)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := p.delay(2); got < time.Second || got > 3*time.Second {
+			t.Fatalf("jitter delay = %v", got)
+		}
+	}
+}
+
+func TestCrashState(t *testing.T) {
+	p := RestartPolicy{MaxRetries: 2, Window: time.Minute, ResetAfter: 2}
+	now := time.Now()
+	var cs crashState
+
+	if stop, _ := cs.fail(p, now); stop {
+		t.Fatal("stop after 1 failure")
+	}
+	if stop, _ := cs.fail(p, now.Add(2*time.Minute)); stop { // 第一次已经超出窗口
+		t.Fatal("stop after window expired")
+	}
+	if cs.succeed(p) || !cs.succeed(p) {
+		t.Fatal("reset after 2 successes")
+	}
+	cs.fail(p, now)
+	cs.fail(p, now)
+	if stop, _ := cs.fail(p, now); !stop {
+		t.Fatal("not stop after 3 failures")
+	}
+}
+
+type retryExecutor struct {
+	runs chan error
+	fail int32
+}
+
+func (e *retryExecutor) Name() string        { return "retry" }
+func (e *retryExecutor) Desc() string        { return "" }
+func (e *retryExecutor) NextDuration() int64 { return 3600 }
+func (e *retryExecutor) Processing() string  { return "" }
+func (e *retryExecutor) RestartPolicy() RestartPolicy {
+	return RestartPolicy{Backoff: 10 * time.Millisecond}
+}
+func (e *retryExecutor) RunEverywhere() bool { return true }
+func (e *retryExecutor) Process(c *Context) (err error) {
+	if atomic.AddInt32(&e.fail, -1) >= 0 {
+		err = errors.New("failed")
+	}
+	e.runs <- err
+	return
+}
+
+func TestScheduler_retryAfterBackoff(t *testing.T) {
+	ex := &retryExecutor{runs: make(chan error, 4), fail: 1}
+	s := &scheduler{executorStatus: make(map[string]*ExecutorStatus), leases: make(map[string]*lease)}
+	s.Register(ex)
+	s.executorStatus[ex.Name()].Status = true
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.started = true
+	s.launch(ex)
+	defer func() { _ = s.Stop(context.Background()) }()
+
+	for i := 0; !s.Once(ex.Name()); i++ { // 等协程进入等待
+		if i > 100 {
+			t.Fatal("once not accepted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// 定时任务失败后退避结束就重试，不等一小时后的下个时间点
+	for i, want := range []bool{true, false} {
+		select {
+		case err := <-ex.runs:
+			if (err != nil) != want {
+				t.Errorf("run %d err = %v", i, err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("run %d not retried", i)
+		}
+	}
+}
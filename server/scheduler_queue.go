@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/scys-devs/lib-go"
+)
+
+// 延迟队列：ready 按执行时间排序；claim 后移到 processing，分数为可见性超时时间；
+// ack 后删除，超时没有ack的会重新投递，超过最大次数后进入 dead
+var (
+	QueueVisibility        = 5 * time.Minute // 领取后多久没有ack就重新投递
+	QueueMaxAttempts int64 = 5               // 最多投递次数，超过后进入死信
+	QueueRetryDelay  int64 = 60              // nack后重试的间隔，乘以已投递次数，单位秒
+	QueueBatch       int64 = 2000            // 每次领取的数量
+)
+
+const min = "-inf"
+
+// QueueItem 领取到的任务
+type QueueItem struct {
+	Member   string
+	Score    float64 // 计划执行时间
+	Attempts int64   // 第几次投递
+}
+
+// 正在处理的成员不重复添加
+var queueAddScript = redis.NewScript(`
+if redis.call('ZSCORE', KEYS[2], ARGV[2]) then
+	return 0
+end
+return redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+`)
+
+// 先把超时的重新投递，再领取到期的
+var queueClaimScript = redis.NewScript(`
+local expired = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[2], 'LIMIT', 0, ARGV[4])
+for _, m in ipairs(expired) do
+	redis.call('ZREM', KEYS[2], m)
+	if tonumber(redis.call('HGET', KEYS[3], m) or '0') >= tonumber(ARGV[5]) then
+		redis.call('ZADD', KEYS[4], ARGV[2], m)
+		redis.call('HDEL', KEYS[3], m)
+	else
+		redis.call('ZADD', KEYS[1], 'NX', ARGV[2], m)
+	end
+end
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'WITHSCORES', 'LIMIT', 0, ARGV[4])
+local ret = {}
+for i = 1, #items, 2 do
+	redis.call('ZREM', KEYS[1], items[i])
+	redis.call('ZADD', KEYS[2], ARGV[3], items[i])
+	table.insert(ret, items[i])
+	table.insert(ret, items[i + 1])
+	table.insert(ret, redis.call('HINCRBY', KEYS[3], items[i], 1))
+end
+return ret
+`)
+
+// 返回1重新投递，0进入死信，-1已经不在处理中
+var queueNackScript = redis.NewScript(`
+if redis.call('ZREM', KEYS[2], ARGV[3]) == 0 then
+	return -1
+end
+if tonumber(redis.call('HGET', KEYS[3], ARGV[3]) or '0') >= tonumber(ARGV[4]) then
+	redis.call('ZADD', KEYS[4], ARGV[1], ARGV[3])
+	redis.call('HDEL', KEYS[3], ARGV[3])
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[3])
+return 1
+`)
+
+var queueRedriveScript = redis.NewScript(`
+local items = redis.call('ZRANGE', KEYS[4], 0, -1)
+for _, m in ipairs(items) do
+	redis.call('ZADD', KEYS[1], 'NX', ARGV[1], m)
+end
+redis.call('DEL', KEYS[4])
+return #items
+`)
+
+// 旧版本的队列key，领取时迁移到新key
+func (s *scheduler) key(name string) string {
+	return fmt.Sprintf("%v:%v", s.prefix, name)
+}
+
+// ready, processing, attempts, dead 使用相同的hash tag，兼容cluster
+func (s *scheduler) queueKeys(name string) []string {
+	ready := fmt.Sprintf("{%v:%v}", s.prefix, name)
+	return []string{ready, ready + ":processing", ready + ":attempts", ready + ":dead"}
+}
+
+// 旧key还存在就迁移，滚动发布期间旧版本写入的数据也能领取到；旧数据直接放回ready
+func (s *scheduler) migrate(name string) {
+	ctx := context.Background()
+	if n, err := s.queue.Exists(ctx, s.key(name)).Result(); err != nil || n == 0 {
+		return
+	}
+	items, err := s.queue.ZRangeWithScores(ctx, s.key(name), 0, -1).Result()
+	if err != nil || len(items) == 0 {
+		return
+	}
+	members := make([]interface{}, 0, len(items))
+	zz := make([]*redis.Z, 0, len(items))
+	for i := range items {
+		members = append(members, items[i].Member)
+		zz = append(zz, &items[i])
+	}
+	if err = s.queue.ZAddNX(ctx, s.queueKeys(name)[0], zz...).Err(); err != nil {
+		log.Errorw("migrate queue", "name", name, "err", err)
+		return
+	}
+	s.queue.ZRem(ctx, s.key(name), members...)
+	log.Infow("migrate queue", "name", name, "cnt", len(items))
+}
+
+// Add 添加一个待执行的任务数据包；已经存在的会更新执行时间，正在处理的会忽略
+func (s *scheduler) Add(name, member string, after int64) {
+	gmtExpire := time.Now().Unix() + after
+	if err := queueAddScript.Run(context.Background(), s.queue, s.queueKeys(name), gmtExpire, member).Err(); err != nil {
+		log.Errorw("put member failed", "name", name, "member", member, "after", after, "err", err)
+	}
+}
+
+// Len 估算消息队列长度，包括正在处理的
+func (s *scheduler) Len(name string) (cnt int64) {
+	keys := s.queueKeys(name)
+	ready, _ := s.queue.ZCard(context.Background(), keys[0]).Result()
+	processing, _ := s.queue.ZCard(context.Background(), keys[1]).Result()
+	return ready + processing
+}
+
+// Claim 领取最多count个执行时间不晚于max的任务，处理完需要Ack，否则超时后会重新投递
+func (s *scheduler) Claim(name, max string, count int64) ([]QueueItem, error) {
+	s.migrate(name)
+	now := time.Now()
+	vals, err := queueClaimScript.Run(context.Background(), s.queue, s.queueKeys(name),
+		max, now.Unix(), now.Add(QueueVisibility).Unix(), count, QueueMaxAttempts).Slice()
+	if err != nil {
+		return nil, err
+	}
+	return parseClaim(vals), nil
+}
+
+// member, score, attempts 依次排列
+func parseClaim(vals []interface{}) []QueueItem {
+	ll := make([]QueueItem, 0, len(vals)/3)
+	for i := 0; i+2 < len(vals); i += 3 {
+		member, _ := vals[i].(string)
+		score, _ := vals[i+1].(string)
+		attempts, _ := vals[i+2].(int64)
+		ll = append(ll, QueueItem{Member: member, Score: lib.StrToFloat64(score), Attempts: attempts})
+	}
+	return ll
+}
+
+// Ack 处理完成
+func (s *scheduler) Ack(name string, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	mm := make([]interface{}, len(members))
+	fields := make([]string, len(members))
+	for i, m := range members {
+		mm[i], fields[i] = m, m
+	}
+	keys := s.queueKeys(name)
+	_, err := s.queue.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.ZRem(context.Background(), keys[1], mm...)
+		pipe.HDel(context.Background(), keys[2], fields...)
+		return nil
+	})
+	return err
+}
+
+// Nack 处理失败，after秒后重新投递；超过最大次数的进入死信
+func (s *scheduler) Nack(name, member string, after int64) error {
+	now := time.Now().Unix()
+	ret, err := queueNackScript.Run(context.Background(), s.queue, s.queueKeys(name), now, now+after, member, QueueMaxAttempts).Int64()
+	if err == nil && ret == 0 {
+		log.Warnw("dead letter", "name", name, "member", member)
+	}
+	return err
+}
+
+// Dead 查看死信
+func (s *scheduler) Dead(name string, limit int64) ([]string, error) {
+	return s.queue.ZRange(context.Background(), s.queueKeys(name)[3], 0, limit-1).Result()
+}
+
+// Redrive 死信全部放回队列，重新计算投递次数
+func (s *scheduler) Redrive(name string) (int64, error) {
+	return queueRedriveScript.Run(context.Background(), s.queue, s.queueKeys(name), time.Now().Unix()).Int64()
+}
+
+// Consume 领取到期的任务逐个处理，返回nil时ack，返回错误时nack；
+// handler panic的任务不会ack，超时后重新投递
+func (s *scheduler) Consume(name, max string, handler func(item QueueItem) error) {
+	for {
+		items, err := s.Claim(name, max, QueueBatch)
+		if err != nil {
+			log.Errorw("claim batch failed", "name", name, "err", err)
+			return
+		}
+		for _, item := range items {
+			if err = handler(item); err != nil {
+				log.Warnw("consume failed", "name", name, "member", item.Member, "attempts", item.Attempts, "err", err)
+				err = s.Nack(name, item.Member, QueueRetryDelay*item.Attempts)
+			} else {
+				err = s.Ack(name, item.Member)
+			}
+			if err != nil {
+				log.Errorw("settle member failed", "name", name, "member", item.Member, "err", err)
+			}
+		}
+		if int64(len(items)) < QueueBatch {
+			return
+		}
+	}
+}
+
+// GetBatch 获取到执行任务时所有的任务，通过queue传出去；handler返回后ack
+func (s *scheduler) GetBatch(name, max string, handler func(item redis.Z)) {
+	s.Consume(name, max, func(item QueueItem) error {
+		handler(redis.Z{Score: item.Score, Member: item.Member})
+		return nil
+	})
+}
@@ -0,0 +1,16 @@
+package server
+
+import "testing"
+
+func TestParseClaim(t *testing.T) {
+	ll := parseClaim([]interface{}{"a", "1700000000", int64(1), "b", "1700000001.5", int64(3)})
+	if len(ll) != 2 {
+		t.Fatalf("len = %v", len(ll))
+	}
+	if ll[0] != (QueueItem{Member: "a", Score: 1700000000, Attempts: 1}) {
+		t.Errorf("item = %+v", ll[0])
+	}
+	if ll[1].Score != 1700000001.5 || ll[1].Attempts != 3 {
+		t.Errorf("item = %+v", ll[1])
+	}
+}
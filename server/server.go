@@ -1,7 +1,15 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/scys-devs/lib-go"
 	"github.com/scys-devs/lib-go/conn"
@@ -18,6 +26,8 @@ var (
 	ServiceLogger = lib.GetLogger("service")
 
 	AccessLogger = lib.GetConsoleLogger("access")
+
+	ShutdownTimeout = 30 * time.Second // 收到退出信号后，等待请求和任务完成的最长时间
 )
 
 // 用户拼接这个站的对外链接
@@ -47,8 +57,31 @@ func Run(port string) {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	// TODO 测试下pm2和平滑重启的兼容性
-	if err := Engine.Run(port); err != nil {
+	srv := &http.Server{Addr: port, Handler: Engine}
+	failed := make(chan error, 1)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			failed <- err
+		}
+	}()
+
+	// 收到退出信号后，先停止接收请求，再等待任务执行完
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(quit)
+	select {
+	case sig := <-quit:
+		fmt.Println("shutdown", sig)
+	case err := <-failed:
 		fmt.Println(err)
 	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		fmt.Println("server shutdown", err)
+	}
+	if err := Scheduler.Stop(ctx); err != nil {
+		fmt.Println("scheduler stop", err)
+	}
 }
@@ -1,36 +1,87 @@
 package lib
 
 import (
-	"strings"
 	"time"
 )
 
 const FormatDay = "2006-01-02"
 const FormatTime = "2006-01-02 15:04:05"
-const FormatISOTime = "2006-01-02T15:04:05+0800"
+const FormatISOTime = "2006-01-02T15:04:05-0700" // 按时间本身的时区输出偏移，东8区的时间仍然是+0800；需要固定东8区时使用FormatISO
 const FormatISOMilliTime = "2006-01-02T15:04:05.000Z07:00"
 const FormatTimeCN = "01月02日15时04分"
 
 const MinTime = "946656000" // 2000年，防止解析时间不正常
 
+// DefaultLocation 按天计算时使用的时区，默认东8区；服务其他地区时在启动时修改
+var DefaultLocation = time.FixedZone("CST", 8*3600)
+
 // DaysAfter n天后0点
 // n=0 今天0点
 // n=1 明天0点
 func DaysAfter(n int64) int64 {
-	return DaysAfterWith(time.Now().Unix(), n)
+	return DaysAfterWith(Now().Unix(), n)
 }
 
 func DaysAfterWith(t, n int64) int64 {
-	return (t+28800+n*86400)/86400*86400 - 28800
+	return DaysAfterIn(time.Unix(t, 0), int(n), DefaultLocation).Unix()
 }
 
-// NextDayWithOffset 明天几点，当然今天也可以
+// NextDayWithOffset 明天几点，当然今天也可以；返回距离现在的秒数，最少1秒
 func NextDayWithOffset(offset int64) int64 {
-	next := DaysAfter(1) + offset - time.Now().Unix()
-	if next > 86400 {
-		return DaysAfter(0) + offset - time.Now().Unix()
+	now := Now()
+	return CeilSeconds(NextLocalTime(now, time.Duration(offset)*time.Second, DefaultLocation).Sub(now))
+}
+
+// CeilSeconds 向上取整的秒数，最少1秒；0会被当作永不过期或者连续执行
+func CeilSeconds(d time.Duration) int64 {
+	if d < time.Second {
+		return 1
+	}
+	return int64((d + time.Second - 1) / time.Second)
+}
+
+// StartOfDay loc时区当天0点
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// StartOfWeek loc时区本周一0点
+func StartOfWeek(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	offset := (int(t.Weekday()) + 6) % 7
+	y, m, d := t.Date()
+	return time.Date(y, m, d-offset, 0, 0, 0, 0, loc)
+}
+
+// StartOfMonth loc时区本月1号0点
+func StartOfMonth(t time.Time, loc *time.Location) time.Time {
+	y, m, _ := t.In(loc).Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, loc)
+}
+
+// DaysAfterIn loc时区n天后0点；按日历日计算，夏令时切换的那天不是86400秒
+func DaysAfterIn(t time.Time, n int, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d+n, 0, 0, 0, 0, loc)
+}
+
+// NextLocalTime t之后下一次到达loc时区当天offset(例如 9*time.Hour 表示9点)的时间，按墙上时间计算
+func NextLocalTime(t time.Time, offset time.Duration, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	for i := 0; ; i++ {
+		next := time.Date(y, m, d+i, 0, 0, 0, int(offset), loc)
+		if next.After(t) {
+			return next
+		}
 	}
-	return next
+}
+
+// PeriodStart t所在周期的开始时间，周期按loc时区的墙上时间对齐，例如 period=86400 是当天0点，夏令时切换当天也是
+func PeriodStart(t time.Time, period int64, loc *time.Location) int64 {
+	_, offset := t.In(loc).Zone()
+	wall := (t.Unix() + int64(offset)) / period * period
+	return time.Date(1970, 1, 1, 0, 0, int(wall), 0, loc).Unix()
 }
 
 func ParseTime(layout, value string) int64 {
@@ -42,6 +93,15 @@ func ParseTime(layout, value string) int64 {
 	}
 }
 
+// ParseTimeIn 按loc时区解析
+func ParseTimeIn(layout, value string, loc *time.Location) int64 {
+	t, _ := time.ParseInLocation(layout, value, loc)
+	if ts := t.Unix(); ts > 0 {
+		return ts
+	}
+	return 0
+}
+
 // 格式化时间戳为指定格式
 func FormatUnix(unix int64, layout string) string {
 	if unix <= 0 {
@@ -50,30 +110,25 @@ func FormatUnix(unix int64, layout string) string {
 	return time.Unix(unix, 0).Format(layout)
 }
 
-// 是否在工作日内
-// TODO 增加节假日判断 https://www.iamwawa.cn/workingday.html
-func InWorkWeek(t time.Time) bool {
-	return !(t.Weekday() == time.Saturday || t.Weekday() == time.Sunday)
+// FormatUnixIn 按loc时区格式化
+func FormatUnixIn(unix int64, layout string, loc *time.Location) string {
+	if unix <= 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).In(loc).Format(layout)
 }
 
-// 是否在工作时间
-func InWorkHour(t time.Time) bool {
-	h := t.Hour()
-	return InWorkWeek(t) && h >= 9 && h < 20
+// FormatISO 转到DefaultLocation后按FormatISOTime格式化，服务器时区不影响结果
+func FormatISO(t time.Time) string {
+	return t.In(DefaultLocation).Format(FormatISOTime)
 }
 
-type DateRange string
-
-func (item DateRange) Start() int64 {
-	if len(item) == 0 {
-		return DaysAfter(0)
-	}
-	return StrToInt64(strings.Split(string(item), ",")[0])
+// 是否在工作日内，节假日和调休使用默认的工作日历 Holidays
+func InWorkWeek(t time.Time) bool {
+	return Holidays.IsWorkday(t)
 }
 
-func (item DateRange) End() int64 {
-	if len(item) == 0 {
-		return DaysAfter(0) + 86400 - 1
-	}
-	return StrToInt64(strings.Split(string(item), ",")[1])
+// 是否在工作时间，默认9点到20点，可以通过 Holidays.SetWorkHours 修改
+func InWorkHour(t time.Time) bool {
+	return Holidays.InWorkHour(t)
 }
@@ -0,0 +1,77 @@
+package lib
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestDaysAfterWith(t *testing.T) {
+	for _, ts := range []int64{1727712000, 1727740799, 1727798399, 1704038400} {
+		for n := int64(-2); n <= 2; n++ {
+			if got, want := DaysAfterWith(ts, n), (ts+28800+n*86400)/86400*86400-28800; got != want {
+				t.Errorf("DaysAfterWith(%v, %v) = %v, want %v", ts, n, got, want)
+			}
+		}
+	}
+}
+
+func TestLocationHelpers(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// 2024-11-03 凌晨2点夏令时结束，这一天有25小时
+	now := time.Date(2024, 11, 2, 12, 0, 0, 0, ny)
+	if got := DaysAfterIn(now, 2, ny); !got.Equal(time.Date(2024, 11, 4, 0, 0, 0, 0, ny)) {
+		t.Errorf("DaysAfterIn = %v", got)
+	}
+	if got := NextLocalTime(now, 9*time.Hour, ny); !got.Equal(time.Date(2024, 11, 3, 9, 0, 0, 0, ny)) {
+		t.Errorf("NextLocalTime = %v", got)
+	}
+	if got := NextLocalTime(now, 13*time.Hour, ny); !got.Equal(time.Date(2024, 11, 2, 13, 0, 0, 0, ny)) {
+		t.Errorf("NextLocalTime = %v", got)
+	}
+	if got := StartOfWeek(now, ny); !got.Equal(time.Date(2024, 10, 28, 0, 0, 0, 0, ny)) {
+		t.Errorf("StartOfWeek = %v", got)
+	}
+	if got := StartOfMonth(now, ny); !got.Equal(time.Date(2024, 11, 1, 0, 0, 0, 0, ny)) {
+		t.Errorf("StartOfMonth = %v", got)
+	}
+	if got := PeriodStart(now, 86400, ny); got != time.Date(2024, 11, 2, 0, 0, 0, 0, ny).Unix() {
+		t.Errorf("PeriodStart = %v", time.Unix(got, 0).In(ny))
+	}
+	// 切换当天中午已经是-0500，0点还是-0400
+	if got := PeriodStart(now.Add(24*time.Hour), 86400, ny); got != time.Date(2024, 11, 3, 0, 0, 0, 0, ny).Unix() {
+		t.Errorf("PeriodStart on DST day = %v", time.Unix(got, 0).In(ny))
+	}
+}
+
+func TestFormatISOTime(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	at := time.Date(2024, 7, 1, 8, 0, 0, 0, ny)
+	if got := at.Format(FormatISOTime); got != "2024-07-01T08:00:00-0400" {
+		t.Errorf("format = %v", got)
+	}
+	if got := FormatISO(at); got != "2024-07-01T20:00:00+0800" {
+		t.Errorf("FormatISO = %v", got)
+	}
+	if got := ParseTime(FormatISOTime, "2024-07-01T20:00:00+0800"); got != at.Unix() {
+		t.Errorf("parse = %v, want %v", got, at.Unix())
+	}
+}
+
+func TestNextDayWithOffset(t *testing.T) {
+	// 最后不到一秒时不能返回0，0作为过期时间表示永不过期
+	at := time.Date(2024, 7, 1, 1, 59, 59, 500e6, DefaultLocation)
+	defer SetClock(FixedClock(at))()
+	if got := NextDayWithOffset(2 * 3600); got != 1 {
+		t.Errorf("NextDayWithOffset = %v, want 1", got)
+	}
+	if got := NextDayWithOffset(3 * 3600); got != 3601 {
+		t.Errorf("NextDayWithOffset = %v, want 3601", got)
+	}
+}